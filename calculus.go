@@ -0,0 +1,41 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+// simpson integrates n > 2 samples f(0..n-1) with the spacings h(i) = x[i+1] - x[i] by the composite
+// Simpson's rule for irregularly spaced data; an odd number of intervals gets a correction for the last one
+func simpson(n int, f, h func(int) float64) float64 {
+	intervals := n - 1
+	result := 0.0
+	for i := 1; i < intervals; i += 2 {
+		h0, h1 := h(i-1), h(i)
+		hph, hdh, hmh := h1+h0, h1/h0, h1*h0
+		result += hph / 6 * ((2-hdh)*f(i-1) + hph*hph/hmh*f(i) + (2-1/hdh)*f(i+1))
+	}
+	if intervals&1 == 1 {
+		h0, h1 := h(intervals-2), h(intervals-1)
+		result += f(intervals) * (2*h1*h1 + 3*h0*h1) / (6 * (h0 + h1))
+		result += f(intervals-1) * (h1*h1 + 3*h1*h0) / (6 * h0)
+		result -= f(intervals-2) * h1 * h1 * h1 / (6 * h0 * (h0 + h1))
+	}
+	return result
+}
@@ -0,0 +1,160 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+// Numerical calculus of IterableFloat64 (the elements are samples y[i] of a function)
+// The methods work on the underlying slice and change neither the slice nor the iterable's index.
+// The *X variants take the sample positions x as a same length iterable instead of a constant spacing dx
+// and return ErrDiffLen if the lengths differ.
+
+// Diff([n=1]) returns a new iterable (len - n) with the n-th order discrete differences
+// y[i+1] - y[i] applied n times (like numpy.diff); empty if n >= len
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Diff(n ...int) *IterableFloat64 {
+	order := 1
+	if len(n) == 1 {
+		order = n[0]
+	}
+	if order < 0 {
+		panic(ERR_ORDER)
+	}
+	if order >= iter.Len {
+		return ToIterFloat64(make([]float64, 0))
+	}
+	newIter := iter.ToList()
+	for o := 0; o < order; o++ {
+		for i := 0; i < len(newIter)-1; i++ {
+			newIter[i] = newIter[i+1] - newIter[i]
+		}
+		newIter = newIter[:len(newIter)-1]
+	}
+	return ToIterFloat64(append(make([]float64, 0, len(newIter)), newIter...))
+}
+
+// Gradient([dx=1]) returns a new iterable (len) with the derivative estimated by central differences
+// (y[i+1] - y[i-1]) / 2dx and one-sided differences at both ends (like numpy.gradient)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Gradient(dx ...realFloat64) *IterableFloat64 {
+	if iter.Len < 2 {
+		panic(ERR_SHORTER2)
+	}
+	h := realFloat64(1)
+	if len(dx) == 1 {
+		h = dx[0]
+	}
+	s := iter.List()
+	last := len(s) - 1
+	newIter := make([]float64, len(s))
+	newIter[0] = float64((realFloat64(s[1]) - realFloat64(s[0])) / h)
+	for i := 1; i < last; i++ {
+		newIter[i] = float64((realFloat64(s[i+1]) - realFloat64(s[i-1])) / (2 * h))
+	}
+	newIter[last] = float64((realFloat64(s[last]) - realFloat64(s[last-1])) / h)
+	return ToIterFloat64(newIter)
+}
+
+// Trapz(dx) returns the integral of the samples with the constant spacing dx by the trapezoidal rule
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Trapz(dx realFloat64) realFloat64 {
+	s := iter.List()
+	sum := realFloat64(0)
+	for i := 1; i < len(s); i++ {
+		sum += (realFloat64(s[i-1]) + realFloat64(s[i])) / 2
+	}
+	return sum * dx
+}
+
+// TrapzX(x) returns the integral of the samples at the positions x by the trapezoidal rule
+// Does not change the underlying original slices
+func (iter *IterableFloat64) TrapzX(x *IterableFloat64) (realFloat64, error) {
+	if x.Len != iter.Len {
+		return 0, ErrDiffLen
+	}
+	s, xs := iter.List(), x.List()
+	sum := realFloat64(0)
+	for i := 1; i < len(s); i++ {
+		sum += (realFloat64(xs[i]) - realFloat64(xs[i-1])) * (realFloat64(s[i-1]) + realFloat64(s[i])) / 2
+	}
+	return sum, nil
+}
+
+// Simpson(dx) returns the integral of the samples with the constant spacing dx by the composite Simpson's rule
+// An even number of samples (odd number of intervals) gets a correction for the last interval
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Simpson(dx realFloat64) realFloat64 {
+	if iter.Len < 3 {
+		return iter.Trapz(dx)
+	}
+	s := iter.List()
+	return simpson(len(s), func(i int) realFloat64 { return realFloat64(s[i]) },
+		func(int) realFloat64 { return dx })
+}
+
+// SimpsonX(x) returns the integral of the samples at the positions x by the composite Simpson's rule
+// for irregularly spaced data
+// Does not change the underlying original slices
+func (iter *IterableFloat64) SimpsonX(x *IterableFloat64) (realFloat64, error) {
+	if x.Len != iter.Len {
+		return 0, ErrDiffLen
+	}
+	if iter.Len < 3 {
+		return iter.TrapzX(x)
+	}
+	s, xs := iter.List(), x.List()
+	return simpson(len(s), func(i int) realFloat64 { return realFloat64(s[i]) },
+		func(i int) realFloat64 { return realFloat64(xs[i+1]) - realFloat64(xs[i]) }), nil
+}
+
+// CumulativeTrapz(dx) returns a new iterable (len) with the running integral by the trapezoidal rule
+// starting with 0 at the first sample (like scipy.integrate.cumulative_trapezoid with initial=0)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) CumulativeTrapz(dx realFloat64) *IterableFloat64 {
+	s := iter.List()
+	newIter := make([]float64, len(s))
+	sum := realFloat64(0)
+	for i := 1; i < len(s); i++ {
+		sum += dx * (realFloat64(s[i-1]) + realFloat64(s[i])) / 2
+		newIter[i] = float64(sum)
+	}
+	return ToIterFloat64(newIter)
+}
+
+// CumulativeTrapzX(x) returns a new iterable (len) with the running integral of the samples
+// at the positions x by the trapezoidal rule starting with 0 at the first sample
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) CumulativeTrapzX(x *IterableFloat64) (*IterableFloat64, error) {
+	if x.Len != iter.Len {
+		return nil, ErrDiffLen
+	}
+	s, xs := iter.List(), x.List()
+	newIter := make([]float64, len(s))
+	sum := realFloat64(0)
+	for i := 1; i < len(s); i++ {
+		sum += (realFloat64(xs[i]) - realFloat64(xs[i-1])) * (realFloat64(s[i-1]) + realFloat64(s[i])) / 2
+		newIter[i] = float64(sum)
+	}
+	return ToIterFloat64(newIter), nil
+}
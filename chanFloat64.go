@@ -0,0 +1,181 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "context"
+
+// Channels and stream stages of float64
+// A stream is a stepwise function func() (float64, bool) returning the next element and a bool indicator
+// for the exhaustion (like MapNext or FilterNext), a StreamFloat64 receiving from a channel or a channel.
+// The stages run in goroutines that send into channels with a bounded buffer, so a slow consumer blocks
+// (back-pressure) its producers.
+// Every goroutine closes its channel and exits when its input is exhausted or the context is done.
+
+// StreamFloat64 is an iterator over the elements received from a channel with the stepwise API of IterableFloat64
+// Its functions must not be called concurrently (use MapStageFloat64 or TeeNextFloat64 to share the elements)
+type StreamFloat64 struct {
+	// Next receives the next element; behind the last element it returns the last one (like IterableFloat64.Next)
+	// This returns the element of the last receive (MINFLOAT64 before the first)
+	Next, This func() float64
+	// Index returns the number of received elements - 1 (-1 before the first receive, the number of elements
+	// when exhausted)
+	Index func() int
+	// MapNext and FilterNext return stepwise functions receiving from the current position (a stream can not reset)
+	MapNext    func(func(float64) float64) func() (float64, bool)
+	FilterNext func(func(float64) bool) func() (float64, bool)
+}
+
+// recvFloat64(ctx, ch) returns a stepwise function receiving the elements of ch and a bool indicator
+// for the exhaustion (ch closed or ctx done)
+func recvFloat64(ctx context.Context, ch <-chan float64) func() (float64, bool) {
+	return func() (float64, bool) {
+		select {
+		case <-ctx.Done():
+			return MINFLOAT64, true
+		case v, ok := <-ch:
+			if !ok {
+				return MINFLOAT64, true
+			}
+			return v, false
+		}
+	}
+}
+
+// FromChanFloat64(ctx, ch) returns a StreamFloat64 receiving the elements of ch until ch is closed or ctx is done;
+// use CollectFloat64 with its MapNext or FilterNext for an iterable
+func FromChanFloat64(ctx context.Context, ch <-chan float64) *StreamFloat64 {
+	var (
+		recv     = recvFloat64(ctx, ch)
+		finished bool
+		this     = MINFLOAT64
+		idx      = -1
+	)
+	pull := func() (float64, bool) {
+		if finished {
+			return MINFLOAT64, true
+		}
+		v, exhausted := recv()
+		idx++
+		if exhausted {
+			finished = true
+			return v, true
+		}
+		this = v
+		return v, false
+	}
+
+	var stream StreamFloat64
+	stream.Next = func() float64 {
+		pull()
+		return this
+	}
+	stream.This = func() float64 { return this }
+	stream.Index = func() int { return idx }
+	stream.MapNext = func(fn func(float64) float64) func() (float64, bool) {
+		return func() (float64, bool) {
+			v, exhausted := pull()
+			if exhausted {
+				return v, true
+			}
+			return fn(v), false
+		}
+	}
+	stream.FilterNext = func(cond func(float64) bool) func() (float64, bool) {
+		return func() (float64, bool) {
+			for v, exhausted := pull(); !exhausted; v, exhausted = pull() {
+				if cond(v) {
+					return v, false
+				}
+			}
+			return MINFLOAT64, true
+		}
+	}
+	return &stream
+}
+
+// CollectFloat64(next) returns a new iterable with the elements of the stepwise function next up to its exhaustion
+// Uses memory (new slice) and the new iterable refers to this new slice
+func CollectFloat64(next func() (float64, bool)) *IterableFloat64 {
+	newIter := make([]float64, 0)
+	for v, exhausted := next(); !exhausted; v, exhausted = next() {
+		newIter = append(newIter, v)
+	}
+	return ToIterFloat64(newIter)
+}
+
+// PipeFloat64(ctx, next, buffer) sends the elements of the stepwise function next (i.e. MapNext or FilterNext
+// of an iterable) from a goroutine into the returned channel with buffer capacity
+// The goroutine closes the channel and exits when next is exhausted or ctx is done
+func PipeFloat64(ctx context.Context, next func() (float64, bool), buffer int) <-chan float64 {
+	out := make(chan float64, buffer)
+	go func() {
+		defer close(out)
+		for v, exhausted := next(); !exhausted; v, exhausted = next() {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- v:
+			}
+		}
+	}()
+	return out
+}
+
+// MapStageFloat64(ctx, in, mapFn, buffer) applies the mapFunction to the elements of in in a goroutine
+// and sends the results into the returned channel with buffer capacity
+// The goroutine closes the channel and exits when in is closed or ctx is done
+func MapStageFloat64(ctx context.Context, in <-chan float64, fn func(float64) float64, buffer int) <-chan float64 {
+	next := recvFloat64(ctx, in)
+	return PipeFloat64(ctx, func() (float64, bool) {
+		v, exhausted := next()
+		if exhausted {
+			return v, true
+		}
+		return fn(v), false
+	}, buffer)
+}
+
+// FilterStageFloat64(ctx, in, condition, buffer) sends the elements of in meeting the condition from a goroutine
+// into the returned channel with buffer capacity
+// The goroutine closes the channel and exits when in is closed or ctx is done
+func FilterStageFloat64(ctx context.Context, in <-chan float64, cond func(float64) bool, buffer int) <-chan float64 {
+	next := recvFloat64(ctx, in)
+	return PipeFloat64(ctx, func() (float64, bool) {
+		for v, exhausted := next(); !exhausted; v, exhausted = next() {
+			if cond(v) {
+				return v, false
+			}
+		}
+		return MINFLOAT64, true
+	}, buffer)
+}
+
+// ToChan(ctx, [buffer=0]) sends the elements from a goroutine into the returned channel with buffer capacity
+// The goroutine closes the channel and exits after the last element or when ctx is done
+// Neither uses nor changes the iterable's index (the goroutine reads with its own Cursor)
+func (iter *IterableFloat64) ToChan(ctx context.Context, buffer ...int) <-chan float64 {
+	b := 0
+	if len(buffer) == 1 {
+		b = buffer[0]
+	}
+	return PipeFloat64(ctx, iter.Cursor().Next, b)
+}
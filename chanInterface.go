@@ -0,0 +1,181 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "context"
+
+// Channels and stream stages of interface{}
+// A stream is a stepwise function func() (interface{}, bool) returning the next element and a bool indicator
+// for the exhaustion (like MapNext or FilterNext), a StreamIf receiving from a channel or a channel.
+// The stages run in goroutines that send into channels with a bounded buffer, so a slow consumer blocks
+// (back-pressure) its producers.
+// Every goroutine closes its channel and exits when its input is exhausted or the context is done.
+
+// StreamIf is an iterator over the elements received from a channel with the stepwise API of IterableIf
+// Its functions must not be called concurrently (use MapStageIf or TeeNextIf to share the elements)
+type StreamIf struct {
+	// Next receives the next element; behind the last element it returns the last one (like IterableIf.Next)
+	// This returns the element of the last receive (nil before the first)
+	Next, This func() interface{}
+	// Index returns the number of received elements - 1 (-1 before the first receive, the number of elements
+	// when exhausted)
+	Index func() int
+	// MapNext and FilterNext return stepwise functions receiving from the current position (a stream can not reset)
+	MapNext    func(func(interface{}) interface{}) func() (interface{}, bool)
+	FilterNext func(func(interface{}) bool) func() (interface{}, bool)
+}
+
+// recvIf(ctx, ch) returns a stepwise function receiving the elements of ch and a bool indicator
+// for the exhaustion (ch closed or ctx done)
+func recvIf(ctx context.Context, ch <-chan interface{}) func() (interface{}, bool) {
+	return func() (interface{}, bool) {
+		select {
+		case <-ctx.Done():
+			return nil, true
+		case v, ok := <-ch:
+			if !ok {
+				return nil, true
+			}
+			return v, false
+		}
+	}
+}
+
+// FromChanIf(ctx, ch) returns a StreamIf receiving the elements of ch until ch is closed or ctx is done;
+// use CollectIf with its MapNext or FilterNext for an iterable
+func FromChanIf(ctx context.Context, ch <-chan interface{}) *StreamIf {
+	var (
+		recv     = recvIf(ctx, ch)
+		finished bool
+		this     interface{}
+		idx      = -1
+	)
+	pull := func() (interface{}, bool) {
+		if finished {
+			return nil, true
+		}
+		v, exhausted := recv()
+		idx++
+		if exhausted {
+			finished = true
+			return v, true
+		}
+		this = v
+		return v, false
+	}
+
+	var stream StreamIf
+	stream.Next = func() interface{} {
+		pull()
+		return this
+	}
+	stream.This = func() interface{} { return this }
+	stream.Index = func() int { return idx }
+	stream.MapNext = func(fn func(interface{}) interface{}) func() (interface{}, bool) {
+		return func() (interface{}, bool) {
+			v, exhausted := pull()
+			if exhausted {
+				return v, true
+			}
+			return fn(v), false
+		}
+	}
+	stream.FilterNext = func(cond func(interface{}) bool) func() (interface{}, bool) {
+		return func() (interface{}, bool) {
+			for v, exhausted := pull(); !exhausted; v, exhausted = pull() {
+				if cond(v) {
+					return v, false
+				}
+			}
+			return nil, true
+		}
+	}
+	return &stream
+}
+
+// CollectIf(next) returns a new iterable with the elements of the stepwise function next up to its exhaustion
+// Uses memory (new slice) and the new iterable refers to this new slice
+func CollectIf(next func() (interface{}, bool)) *IterableIf {
+	newIter := make([]interface{}, 0)
+	for v, exhausted := next(); !exhausted; v, exhausted = next() {
+		newIter = append(newIter, v)
+	}
+	return ToIterIf(newIter)
+}
+
+// PipeIf(ctx, next, buffer) sends the elements of the stepwise function next (i.e. MapNext or FilterNext
+// of an iterable) from a goroutine into the returned channel with buffer capacity
+// The goroutine closes the channel and exits when next is exhausted or ctx is done
+func PipeIf(ctx context.Context, next func() (interface{}, bool), buffer int) <-chan interface{} {
+	out := make(chan interface{}, buffer)
+	go func() {
+		defer close(out)
+		for v, exhausted := next(); !exhausted; v, exhausted = next() {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- v:
+			}
+		}
+	}()
+	return out
+}
+
+// MapStageIf(ctx, in, mapFn, buffer) applies the mapFunction to the elements of in in a goroutine
+// and sends the results into the returned channel with buffer capacity
+// The goroutine closes the channel and exits when in is closed or ctx is done
+func MapStageIf(ctx context.Context, in <-chan interface{}, fn func(interface{}) interface{}, buffer int) <-chan interface{} {
+	next := recvIf(ctx, in)
+	return PipeIf(ctx, func() (interface{}, bool) {
+		v, exhausted := next()
+		if exhausted {
+			return v, true
+		}
+		return fn(v), false
+	}, buffer)
+}
+
+// FilterStageIf(ctx, in, condition, buffer) sends the elements of in meeting the condition from a goroutine
+// into the returned channel with buffer capacity
+// The goroutine closes the channel and exits when in is closed or ctx is done
+func FilterStageIf(ctx context.Context, in <-chan interface{}, cond func(interface{}) bool, buffer int) <-chan interface{} {
+	next := recvIf(ctx, in)
+	return PipeIf(ctx, func() (interface{}, bool) {
+		for v, exhausted := next(); !exhausted; v, exhausted = next() {
+			if cond(v) {
+				return v, false
+			}
+		}
+		return nil, true
+	}, buffer)
+}
+
+// ToChan(ctx, [buffer=0]) sends the elements from a goroutine into the returned channel with buffer capacity
+// The goroutine closes the channel and exits after the last element or when ctx is done
+// Neither uses nor changes the iterable's index (the goroutine reads with its own Cursor)
+func (iter *IterableIf) ToChan(ctx context.Context, buffer ...int) <-chan interface{} {
+	b := 0
+	if len(buffer) == 1 {
+		b = buffer[0]
+	}
+	return PipeIf(ctx, iter.Cursor().Next, b)
+}
@@ -35,10 +35,127 @@ const (
 	MINBYTE    = byte(0)
 	MINSTRING  = ""
 
-	ERR_SHORTER1 = "Iterable with lenght smaller 1"
-	ERR_SHORTER2 = "Iterable with lenght smaller 2 - need at least 2 elements"
-	ERR_DIFFTYPE = "Can not use different types in an iterable - need purity"
-	ERR_DIFFLEN  = "Parameter error: underlying slices differ in length"
-	ERR_ODDLEN   = "Pairwise operation: Underlying slices has odd length"
-	ERR_WRONGLEN = "Length does not match op function steps"
+	MINCOMPLEX128 = complex(MINFLOAT64, MINFLOAT64)
+
+	ERR_SHORTER1  = "Iterable with lenght smaller 1"
+	ERR_SHORTER2  = "Iterable with lenght smaller 2 - need at least 2 elements"
+	ERR_DIFFTYPE  = "Can not use different types in an iterable - need purity"
+	ERR_DIFFLEN   = "Parameter error: underlying slices differ in length"
+	ERR_ODDLEN    = "Pairwise operation: Underlying slices has odd length"
+	ERR_WRONGLEN  = "Length does not match op function steps"
+	ERR_QUANTILE  = "Parameter error: quantile q must be within [0, 1]"
+	ERR_METHOD    = "Parameter error: unknown method"
+	ERR_WINDOW    = "Parameter error: window must be within [1, length of the iterable]"
+	ERR_ALPHA     = "Parameter error: alpha must be within (0, 1]"
+	ERR_SPAN      = "Parameter error: span must be at least 1"
+	ERR_ORDER     = "Parameter error: order must not be negative"
+	ERR_NOTSORTED = "Parameter error: positions must be strictly increasing"
+	ERR_THRESHOLD = "Parameter error: need at least 3 points to downsample"
+	ERR_CLIP      = "Parameter error: lo must not be greater than hi"
+	ERR_FILLVALUE = "Parameter error: FILLCONST needs exactly one value"
+	ERR_BINS      = "Parameter error: need at least 1 bin"
+	ERR_WORKERS   = "Parameter error: need at least 1 worker"
+	ERR_COPIES    = "Parameter error: need at least 1 copy"
+
+	// default absolute and relative tolerances of ApproxEqual, AnyApprox and AllApprox (the defaults of numpy.isclose,
+	// but the test |a - b| <= atol + rtol * max(|a|, |b|) is symmetric where numpy.isclose scales rtol by |b| only)
+	APPROXATOL = 1e-8
+	APPROXRTOL = 1e-5
+
+	// default thresholds of the methods of Outliers
+	ZSCORETHRESHOLD = 3
+	MADTHRESHOLD    = 3.5
+	IQRFACTOR       = 1.5
+
+	// length below which ParallelAccumulate and ParallelSortInto run serially
+	PARALLELTHRESHOLD = 1 << 13
+
+	// default number of elements between the checks of the context in the *Ctx methods
+	CTXCHECK = 1024
+
+	// default number of bins of Histogram (like numpy.histogram)
+	HISTBINS = 10
+
+	// length below which SumPairwise adds up the elements in a plain loop
+	PAIRWISEBLOCK = 128
+)
+
+// interpolation methods of Quantile (like the methods of numpy.quantile)
+// for a quantile between the sorted elements i and j
+const (
+	QLINEAR   = iota // i + (j - i) * fraction
+	QLOWER           // i
+	QHIGHER          // j
+	QNEAREST         // i or j whichever is nearest (half to even index)
+	QMIDPOINT        // (i + j) / 2
+)
+
+// tie strategies of Rank (like the methods of scipy.stats.rankdata)
+const (
+	RANKAVERAGE = iota // average of the ranks of the tied elements
+	RANKMIN            // lowest rank of the tied elements
+	RANKMAX            // highest rank of the tied elements
+	RANKDENSE          // lowest rank, the next distinct element gets the next rank
+	RANKORDINAL        // distinct ranks in the order of the elements
+)
+
+// methods of FillNaN
+const (
+	FILLCONST    = iota // a constant value
+	FILLFORWARD         // the previous element
+	FILLBACKWARD        // the next element
+	FILLLINEAR          // linear interpolation between the neighbours
+)
+
+// methods of Outliers, DropOutliers and Winsorize
+const (
+	OUTZSCORE = iota // z-score
+	OUTMAD           // modified z-score with the median absolute deviation
+	OUTIQR           // Tukey's fences with the interquartile range
+)
+
+// policies of MapErr, FilterErr and ReduceErr for failing elements
+const (
+	ERRSTOP    = iota // stop at the first error
+	ERRSKIP           // leave out the failing elements
+	ERRCOLLECT        // leave out the failing elements and collect their errors
+)
+
+// vector norms of Norm
+const (
+	NORML2  = iota // euclidean norm sqrt(sum(x*x))
+	NORML1         // sum(|x|)
+	NORMINF        // max(|x|)
+)
+
+// modes of Convolve and Correlate (like numpy.convolve)
+const (
+	CONVFULL  = iota // every overlap (len + kernel len - 1)
+	CONVSAME         // centered with the length of the longer input
+	CONVVALID        // only complete overlaps (longer - shorter + 1)
+)
+
+// window functions of Windowed
+const (
+	WINHANN = iota
+	WINHAMMING
+	WINBLACKMAN
+)
+
+// interpolation methods of Interp<T>
+const (
+	INTERPLINEAR  = iota
+	INTERPNEAREST // value of the nearest sample
+	INTERPSTEP    // value of the previous sample (zero-order hold)
+	INTERPCUBIC   // natural cubic spline
+)
+
+// Placeholders used by the numeric templates (s. numTemplates and floatTemplates in makeMoreItertools.go)
+// go:generate replaces sum<T> by the type used to accumulate elements of type <T> without overflow
+// (i.e. int64 for int8), real<T> by float64 and realIter<T> by IterableFloat64,
+// so the generated files do not refer to them
+type (
+	sumFloat64      = float64
+	realFloat64     = float64
+	realIterFloat64 = IterableFloat64
 )
@@ -0,0 +1,162 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "context"
+
+// Cancelable map, filter and reduce of IterableFloat64
+// The *Ctx methods check the context before every every-th element (default CTXCHECK) and stop when it is done
+// returning the partial result, the position reached (the number of elements handled) and ctx.Err().
+// Without a cancel they return the same results as Map, Filter, Reduce, ParallelMap and MMapToIterFloat64, Len and nil.
+
+// MapCtx(ctx, mapFn, [every=CTXCHECK]) applies the mapFunction to the elements until ctx is done and returns a new
+// iterable with the results of the first pos elements, pos and ctx.Err() (nil after all elements)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) MapCtx(ctx context.Context, fn func(float64) float64, every ...int) (*IterableFloat64, int, error) {
+	s := iter.List()
+	e := ctxEvery(every)
+	newIter := make([]float64, len(s))
+	for i, v := range s {
+		if i%e == 0 {
+			if err := ctx.Err(); err != nil {
+				return ToIterFloat64(newIter[:i]), i, err
+			}
+		}
+		newIter[i] = fn(v)
+	}
+	return ToIterFloat64(newIter), len(s), nil
+}
+
+// FilterCtx(ctx, condition, [every=CTXCHECK]) returns a new iterable with the elements meeting the condition
+// among the first pos elements checked until ctx is done, pos and ctx.Err() (nil after all elements)
+// Uses memory (new slice up to the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) FilterCtx(ctx context.Context, cond func(float64) bool, every ...int) (*IterableFloat64, int, error) {
+	s := iter.List()
+	e := ctxEvery(every)
+	newIter := make([]float64, 0, len(s))
+	for i, v := range s {
+		if i%e == 0 {
+			if err := ctx.Err(); err != nil {
+				return ToIterFloat64(newIter), i, err
+			}
+		}
+		if cond(v) {
+			newIter = append(newIter, v)
+		}
+	}
+	return ToIterFloat64(newIter), len(s), nil
+}
+
+// ReduceCtx(ctx, reduceFn, [every=CTXCHECK]) reduces the elements like Reduce until ctx is done and returns
+// the state over the first pos elements (the state is the first element, pos 1, for a done ctx), pos and ctx.Err()
+// (nil after all elements)
+// Panics with ERR_SHORTER1 for an empty iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) ReduceCtx(ctx context.Context, fn func(float64, float64) float64, every ...int) (float64, int, error) {
+	s := iter.List()
+	if len(s) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	e := ctxEvery(every)
+	state := s[0]
+	if err := ctx.Err(); err != nil {
+		return state, 1, err
+	}
+	for i := 1; i < len(s); i++ {
+		if i%e == 0 {
+			if err := ctx.Err(); err != nil {
+				return state, i, err
+			}
+		}
+		state = fn(state, s[i])
+	}
+	return state, len(s), nil
+}
+
+// ParallelMapCtx(ctx, mapFn, [every=CTXCHECK, workers]) applies the mapFunction like ParallelMap (with workers
+// goroutines) until ctx is done; every worker checks ctx before every every-th element of its part
+// (CTXCHECK for every < 1, i.e. ParallelMapCtx(ctx, mapFn, 0, 4) for the default with 4 workers)
+// Returns a new iterable with the results of the first pos elements (the prefix all workers completed), pos and ctx.Err()
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) ParallelMapCtx(ctx context.Context, fn func(float64) float64, opts ...int) (*IterableFloat64, int, error) {
+	var every, workers []int
+	if len(opts) > 0 {
+		every, workers = opts[:1], opts[1:]
+	}
+	s := iter.List()
+	e := ctxEvery(every)
+	newIter := make([]float64, len(s))
+	parts := parallelParts(len(s), parallelWorkers(len(s), workers))
+	// done[p] is the number of mapped elements of part p
+	done := make([]int, len(parts))
+	parallelDo(parts, func(p, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			if (i-lo)%e == 0 && ctx.Err() != nil {
+				return
+			}
+			newIter[i] = fn(s[i])
+			done[p]++
+		}
+	})
+	pos := 0
+	for p, bounds := range parts {
+		pos += done[p]
+		if done[p] < bounds[1]-bounds[0] {
+			return ToIterFloat64(newIter[:pos]), pos, ctx.Err()
+		}
+	}
+	return ToIterFloat64(newIter), len(s), nil
+}
+
+// MMapToIterFloat64Ctx(ctx, mapFn, seqs...) maps the function over the slices like MMapToIterFloat64 until ctx is done
+// checking it before every CTXCHECK-th element (the slices take the variadic place of every)
+// Returns a new iterable with the results of the first pos elements, pos and ctx.Err() (nil after all elements)
+// Attention!  All slices to be multi-mapped need to be of the same length
+// Uses memory (new slice with the dimensions of a given slice) and the new iterable refers to this new slice
+func MMapToIterFloat64Ctx(ctx context.Context, fn func([]float64) float64, seqs ...[]float64) (*IterableFloat64, int, error) {
+	if len(seqs) < 2 {
+		panic(ERR_SHORTER2)
+	}
+	slen := len(seqs[0])
+	for _, seq := range seqs {
+		if len(seq) != slen {
+			panic(ERR_DIFFLEN)
+		}
+	}
+	newIter := make([]float64, slen)
+	vals := make([]float64, len(seqs))
+	for i := range newIter {
+		if i%CTXCHECK == 0 {
+			if err := ctx.Err(); err != nil {
+				return ToIterFloat64(newIter[:i]), i, err
+			}
+		}
+		for ii := range vals {
+			vals[ii] = seqs[ii][i]
+		}
+		newIter[i] = fn(vals)
+	}
+	return ToIterFloat64(newIter), slen, nil
+}
@@ -0,0 +1,93 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+// CursorIf is an independent read cursor over the underlying slice of an IterableIf
+// All methods of an iterable share one index (Any and All move it, the stepwise functions reset it),
+// every cursor has its own, so several readers can traverse one iterable without interfering.
+// A cursor is not safe for concurrent use, give every goroutine its own.
+type CursorIf struct {
+	s   []interface{}
+	idx int
+}
+
+// Cursor() returns a new CursorIf before the first element over the same underlying slice
+// Neither uses nor changes the iterable's index
+// No additional memory needed
+func (iter *IterableIf) Cursor() *CursorIf {
+	return &CursorIf{s: iter.List(), idx: -1}
+}
+
+// at returns the element at idx (nil outside) and a bool indicator for idx being outside of the slice
+func (c *CursorIf) at(idx int) (interface{}, bool) {
+	if idx < 0 || idx >= len(c.s) {
+		return nil, true
+	}
+	return c.s[idx], false
+}
+
+// Next() moves the cursor forward and returns the element and a bool indicator for the exhaustion
+// (the cursor stops behind the last element)
+func (c *CursorIf) Next() (interface{}, bool) {
+	if c.idx < len(c.s) {
+		c.idx++
+	}
+	return c.at(c.idx)
+}
+
+// Back() moves the cursor backward and returns the element and a bool indicator for the exhaustion
+// (the cursor stops before the first element)
+func (c *CursorIf) Back() (interface{}, bool) {
+	if c.idx >= 0 {
+		c.idx--
+	}
+	return c.at(c.idx)
+}
+
+// Peek() returns the element Next would return without moving the cursor
+func (c *CursorIf) Peek() (interface{}, bool) {
+	return c.at(c.idx + 1)
+}
+
+// Index() returns the cursor's index (-1 before the first element, Len behind the last)
+func (c *CursorIf) Index() int {
+	return c.idx
+}
+
+// SetIndex(idx) moves the cursor to idx (limited to -1 ... Len) and returns the resulting index
+// and a bool indicator for being outside of the elements
+func (c *CursorIf) SetIndex(idx int) (int, bool) {
+	switch {
+	case idx < -1:
+		idx = -1
+	case idx > len(c.s):
+		idx = len(c.s)
+	}
+	c.idx = idx
+	_, outside := c.at(idx)
+	return c.idx, outside
+}
+
+// Reset() moves the cursor before the first element
+func (c *CursorIf) Reset() {
+	c.idx = -1
+}
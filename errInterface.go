@@ -0,0 +1,93 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+// Map, filter and reduce of IterableIf with functions that can fail
+// The policy decides about failing elements: ERRSTOP (default) stops at the first error and returns
+// the results so far with an *ElementError, ERRSKIP leaves them out, ERRCOLLECT leaves them out and returns
+// the ElementErrors of all of them (the index and error of every failing element).
+
+// MapErr(mapFn, [policy=ERRSTOP]) applies the mapFunction to all elements and returns a new iterator with
+// the resulting values of the elements that did not fail and the error by policy
+// Uses memory (new slice up to the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableIf) MapErr(fn func(interface{}) (interface{}, error), policy ...int) (*IterableIf, error) {
+	c := newErrCollector(policy)
+	newIter := make([]interface{}, 0, iter.Len)
+	for i, v := range iter.List() {
+		r, err := fn(v)
+		if err != nil {
+			if c.failed(i, err) {
+				break
+			}
+			continue
+		}
+		newIter = append(newIter, r)
+	}
+	return ToIterIf(newIter), c.err()
+}
+
+// FilterErr(condition, [policy=ERRSTOP]) returns a new iterable with the elements meeting the condition
+// (failing elements are left out) and the error by policy
+// Uses memory (new slice up to the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableIf) FilterErr(cond func(interface{}) (bool, error), policy ...int) (*IterableIf, error) {
+	c := newErrCollector(policy)
+	newIter := make([]interface{}, 0, iter.Len)
+	for i, v := range iter.List() {
+		ok, err := cond(v)
+		if err != nil {
+			if c.failed(i, err) {
+				break
+			}
+			continue
+		}
+		if ok {
+			newIter = append(newIter, v)
+		}
+	}
+	return ToIterIf(newIter), c.err()
+}
+
+// ReduceErr(reduceFn, [policy=ERRSTOP]) reduces the elements like Reduce and returns the state and the error
+// by policy; the state stays unchanged by failing elements
+// Panics with ERR_SHORTER1 for an empty iterable
+// Does not change the underlying original slice
+func (iter *IterableIf) ReduceErr(fn func(interface{}, interface{}) (interface{}, error), policy ...int) (interface{}, error) {
+	s := iter.List()
+	if len(s) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	c := newErrCollector(policy)
+	state := s[0]
+	for i := 1; i < len(s); i++ {
+		next, err := fn(state, s[i])
+		if err != nil {
+			if c.failed(i, err) {
+				break
+			}
+			continue
+		}
+		state = next
+	}
+	return state, c.err()
+}
@@ -0,0 +1,104 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Errors of the *Err methods (s. errFloat64.go)
+
+// ElementError is the error of the function applied to the element at Index
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error of the function, so errors.Is and errors.As look into it
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// ElementErrors are the errors of all failing elements collected by the policy ERRCOLLECT
+type ElementErrors []*ElementError
+
+// Error joins the errors of the elements by newlines
+func (e ElementErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the errors of the elements, so errors.Is and errors.As look into all of them
+func (e ElementErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// errCollector handles the errors of the elements by policy
+type errCollector struct {
+	policy int
+	errs   ElementErrors
+}
+
+// newErrCollector returns an errCollector with the policy (default ERRSTOP)
+func newErrCollector(policy []int) *errCollector {
+	c := &errCollector{policy: ERRSTOP}
+	if len(policy) == 1 {
+		c.policy = policy[0]
+	}
+	switch c.policy {
+	case ERRSTOP, ERRSKIP, ERRCOLLECT:
+	default:
+		panic(ERR_METHOD)
+	}
+	return c
+}
+
+// failed records the error of the element at index i and reports if the operation has to stop
+func (c *errCollector) failed(i int, err error) bool {
+	if c.policy != ERRSKIP {
+		c.errs = append(c.errs, &ElementError{Index: i, Err: err})
+	}
+	return c.policy == ERRSTOP
+}
+
+// err returns the *ElementError (ERRSTOP), ElementErrors (ERRCOLLECT) or nil
+func (c *errCollector) err() error {
+	switch {
+	case len(c.errs) == 0:
+		return nil
+	case c.policy == ERRSTOP:
+		return c.errs[0]
+	}
+	return c.errs
+}
@@ -0,0 +1,65 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+// Fast paths of IterableFloat64 using the SSE2 kernels on amd64 (kernels_amd64.s)
+// and 4 times unrolled loops elsewhere (kernels_noasm.go)
+// The lengths are checked on the slices (not the Len fields) before a kernel runs, the SSE2 kernels do not
+// check bounds.
+// SumFast and DotFast accumulate in float64 with several partial sums, Sum and Dot accumulate
+// in sumFloat64 one by one, so the results may differ in the last bits.
+
+// SumFast() returns the sum of all elements
+// Does not change the underlying original slice
+func (iter *IterableFloat64) SumFast() float64 {
+	return sumKernelFloat64(iter.List())
+}
+
+// DotFast(other) returns the dot product sum(iter[i] * other[i])
+// Does not change the underlying original slices
+func (iter *IterableFloat64) DotFast(other *IterableFloat64) float64 {
+	x, y := iter.List(), other.List()
+	if len(x) != len(y) {
+		panic(ERR_DIFFLEN)
+	}
+	return dotKernelFloat64(x, y)
+}
+
+// Scale(a) multiplies every element by a changing the underlying slice to the result and returns itself
+// (like MapInto(func(x float64) float64 { return a * x }) without a function call per element)
+// No additional memory needed
+func (iter *IterableFloat64) Scale(a float64) *IterableFloat64 {
+	scaleKernelFloat64(a, iter.List())
+	return iter
+}
+
+// AXPY(a, x) adds a * x element-wise changing the underlying slice to the result and returns itself
+// (iter[i] += a * x[i] like BLAS axpy)
+// No additional memory needed
+func (iter *IterableFloat64) AXPY(a float64, x *IterableFloat64) *IterableFloat64 {
+	xs, ys := x.List(), iter.List()
+	if len(xs) != len(ys) {
+		panic(ERR_DIFFLEN)
+	}
+	axpyKernelFloat64(a, xs, ys)
+	return iter
+}
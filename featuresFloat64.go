@@ -0,0 +1,199 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"math"
+	"sort"
+)
+
+// Feature scaling of IterableFloat64 (instead of MapInto closures)
+// The plain methods return new iterables, the *Into variants change the underlying slice and return the iterable itself.
+
+// normalizeFloat64 scales src min-max to [a, b] into dst, constant elements become a
+func normalizeFloat64(dst, src []float64, bounds []float64) {
+	if len(src) == 0 {
+		return
+	}
+	a, b := float64(0), float64(1)
+	if len(bounds) == 2 {
+		a, b = bounds[0], bounds[1]
+	}
+	lo, hi := src[0], src[0]
+	for _, v := range src {
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	rng := realFloat64(hi) - realFloat64(lo)
+	for i, v := range src {
+		if rng == 0 {
+			dst[i] = a
+			continue
+		}
+		dst[i] = float64(realFloat64(a) + (realFloat64(v)-realFloat64(lo))/rng*(realFloat64(b)-realFloat64(a)))
+	}
+}
+
+// standardizeFloat64 writes the z-scores of src into dst, constant elements become 0
+func standardizeFloat64(dst, src []float64, ddof []int) {
+	d := 0
+	if len(ddof) == 1 {
+		d = ddof[0]
+	}
+	m := momentsFloat64(src)
+	sd := math.Sqrt(m.variance(d))
+	for i, v := range src {
+		if sd == 0 {
+			dst[i] = 0
+			continue
+		}
+		dst[i] = float64((realFloat64(v) - m.mean) / sd)
+	}
+}
+
+// clipFloat64 limits src to [lo, hi] into dst
+func clipFloat64(dst, src []float64, lo, hi float64) {
+	if lo > hi {
+		panic(ERR_CLIP)
+	}
+	for i, v := range src {
+		switch {
+		case v < lo:
+			dst[i] = lo
+		case v > hi:
+			dst[i] = hi
+		default:
+			dst[i] = v
+		}
+	}
+}
+
+// rankFloat64 writes the ranks of src into dst
+func rankFloat64(dst, src []float64, method []int) {
+	m := RANKAVERAGE
+	if len(method) == 1 {
+		m = method[0]
+	}
+	for i, r := range ranks(len(src), func(i int) realFloat64 { return realFloat64(src[i]) }, m) {
+		dst[i] = float64(r)
+	}
+}
+
+// Normalize([a=0, b=1]) returns a new iterable with the elements scaled min-max to [a, b]
+// If all elements are equal they become a
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Normalize(bounds ...float64) *IterableFloat64 {
+	newIter := make([]float64, iter.Len)
+	normalizeFloat64(newIter, iter.List(), bounds)
+	return ToIterFloat64(newIter)
+}
+
+// NormalizeInto([a=0, b=1]) scales the elements min-max to [a, b] changing the underlying slice and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) NormalizeInto(bounds ...float64) *IterableFloat64 {
+	normalizeFloat64(iter.List(), iter.List(), bounds)
+	return iter
+}
+
+// Standardize([ddof=0]) returns a new iterable with the z-scores (x - mean) / stddev of the elements
+// (like scipy.stats.zscore the stddev has ddof=0 by default, s. Variance); if all elements are equal they become 0
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Standardize(ddof ...int) *IterableFloat64 {
+	newIter := make([]float64, iter.Len)
+	standardizeFloat64(newIter, iter.List(), ddof)
+	return ToIterFloat64(newIter)
+}
+
+// StandardizeInto([ddof=0]) replaces the elements by their z-scores changing the underlying slice and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) StandardizeInto(ddof ...int) *IterableFloat64 {
+	standardizeFloat64(iter.List(), iter.List(), ddof)
+	return iter
+}
+
+// Clip(lo, hi) returns a new iterable with the elements limited to [lo, hi]
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Clip(lo, hi float64) *IterableFloat64 {
+	newIter := make([]float64, iter.Len)
+	clipFloat64(newIter, iter.List(), lo, hi)
+	return ToIterFloat64(newIter)
+}
+
+// ClipInto(lo, hi) limits the elements to [lo, hi] changing the underlying slice and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) ClipInto(lo, hi float64) *IterableFloat64 {
+	clipFloat64(iter.List(), iter.List(), lo, hi)
+	return iter
+}
+
+// Rank([method=RANKAVERAGE]) returns a new iterable with the ranks 1..Len of the elements
+// ties get the rank by method RANKAVERAGE, RANKMIN, RANKMAX, RANKDENSE or RANKORDINAL (like scipy.stats.rankdata),
+// NaN elements get the rank NaN and the others are ranked 1..Len - CountNaN (like pandas rank)
+// Uses memory (new slice with the originals dimensions and a sorted index) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Rank(method ...int) *IterableFloat64 {
+	newIter := make([]float64, iter.Len)
+	rankFloat64(newIter, iter.List(), method)
+	return ToIterFloat64(newIter)
+}
+
+// RankInto([method=RANKAVERAGE]) replaces the elements by their ranks (s. Rank) changing the underlying slice and returns itself
+// Uses memory (sorted index)
+func (iter *IterableFloat64) RankInto(method ...int) *IterableFloat64 {
+	rankFloat64(iter.List(), iter.List(), method)
+	return iter
+}
+
+// Argsort() returns an *IterableInt with the indices that sort the elements ascending (stable for equal elements),
+// the indices of NaN come last (like numpy.argsort)
+// Uses memory (new []int slice with the originals length)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Argsort() *IterableInt {
+	s := iter.List()
+	idx := make([]int, len(s))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return lessNaNLast(realFloat64(s[idx[i]]), realFloat64(s[idx[j]])) })
+	return ToIterInt(idx)
+}
+
+// ArgsortInto() sorts the elements ascending changing the underlying slice and returns an *IterableInt
+// with their original indices (s. Argsort)
+// Uses memory (new []int slice with the originals length)
+func (iter *IterableFloat64) ArgsortInto() *IterableInt {
+	indices := iter.Argsort()
+	s := iter.List()
+	sorted := make([]float64, len(s))
+	for i, idx := range indices.List() {
+		sorted[i] = s[idx]
+	}
+	copy(s, sorted)
+	return indices
+}
@@ -0,0 +1,295 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Spectral analysis over IterableFloat64 and IterableComplex128
+// A radix-2 FFT is used for power of 2 lengths, Bluestein's chirp-z algorithm (on top of the radix-2 FFT)
+// for every other length, so all transforms are O(n log n).
+// The methods do not change the underlying original slices and return new iterables.
+
+// isPow2 reports if n is a power of 2
+func isPow2(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// fftRadix2 transforms x (len power of 2) in place; inverse uses the positive exponent and does not scale
+func fftRadix2(x []complex128, inverse bool) {
+	n := len(x)
+	// bit reversed order
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	// twiddle factors exp(sign * 2πi k/n) computed directly for accuracy
+	twiddle := make([]complex128, n>>1)
+	for k := range twiddle {
+		sin, cos := math.Sincos(sign * 2 * math.Pi * float64(k) / float64(n))
+		twiddle[k] = complex(cos, sin)
+	}
+	for size := 2; size <= n; size <<= 1 {
+		half, stride := size>>1, n/size
+		for start := 0; start < n; start += size {
+			for k := 0; k < half; k++ {
+				t := twiddle[k*stride] * x[start+k+half]
+				x[start+k+half] = x[start+k] - t
+				x[start+k] += t
+			}
+		}
+	}
+}
+
+// fftBluestein transforms x (any length) in place by a convolution with a chirp using fftRadix2
+func fftBluestein(x []complex128, inverse bool) {
+	n := len(x)
+	m := 1
+	for m < 2*n-1 {
+		m <<= 1
+	}
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	// chirp[k] = exp(sign * πi k²/n), k² is taken modulo 2n to keep the angle small
+	chirp := make([]complex128, n)
+	for k := range chirp {
+		sin, cos := math.Sincos(sign * math.Pi * float64((int64(k)*int64(k))%(2*int64(n))) / float64(n))
+		chirp[k] = complex(cos, sin)
+	}
+	a, b := make([]complex128, m), make([]complex128, m)
+	for k := 0; k < n; k++ {
+		a[k] = x[k] * chirp[k]
+	}
+	b[0] = cmplx.Conj(chirp[0])
+	for k := 1; k < n; k++ {
+		b[k] = cmplx.Conj(chirp[k])
+		b[m-k] = b[k]
+	}
+	fftRadix2(a, false)
+	fftRadix2(b, false)
+	for i := range a {
+		a[i] *= b[i]
+	}
+	fftRadix2(a, true)
+	for k := 0; k < n; k++ {
+		x[k] = chirp[k] * a[k] / complex(float64(m), 0)
+	}
+}
+
+// fft transforms a copy of x and returns it; inverse scales by 1/n
+func fft(x []complex128, inverse bool) []complex128 {
+	if len(x) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	y := append(make([]complex128, 0, len(x)), x...)
+	if isPow2(len(y)) {
+		fftRadix2(y, inverse)
+	} else {
+		fftBluestein(y, inverse)
+	}
+	if inverse {
+		scale := complex(1/float64(len(y)), 0)
+		for i := range y {
+			y[i] *= scale
+		}
+	}
+	return y
+}
+
+// toComplex128 returns the real samples s as complex128
+func toComplex128(s []float64) []complex128 {
+	c := make([]complex128, len(s))
+	for i, v := range s {
+		c[i] = complex(v, 0)
+	}
+	return c
+}
+
+// FFT() returns a new iterable with the discrete Fourier transform of the elements (like numpy.fft.fft)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableComplex128) FFT() *IterableComplex128 {
+	return ToIterComplex128(fft(iter.List(), false))
+}
+
+// IFFT() returns a new iterable with the inverse discrete Fourier transform (scaled by 1/n like numpy.fft.ifft)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableComplex128) IFFT() *IterableComplex128 {
+	return ToIterComplex128(fft(iter.List(), true))
+}
+
+// IRFFT([n=2*(len-1)]) returns a new IterableFloat64 with the n real samples whose RFFT are the elements
+// (the non-negative frequency bins of a Hermitian spectrum like numpy.fft.irfft)
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableComplex128) IRFFT(n ...int) *IterableFloat64 {
+	bins := iter.List()
+	length := 2 * (len(bins) - 1)
+	if len(n) == 1 {
+		length = n[0]
+	}
+	if length < 1 {
+		panic(ERR_SHORTER1)
+	}
+	full := make([]complex128, length)
+	for k := 0; k <= length/2 && k < len(bins); k++ {
+		full[k] = bins[k]
+		if k > 0 && k < length-k {
+			full[length-k] = cmplx.Conj(bins[k])
+		}
+	}
+	// the imaginary parts of the bins 0 (and n/2 for even n) are ignored like numpy does
+	full[0] = complex(real(full[0]), 0)
+	if length&1 == 0 && length/2 < len(bins) {
+		full[length/2] = complex(real(full[length/2]), 0)
+	}
+	samples := fft(full, true)
+	newIter := make([]float64, length)
+	for i, v := range samples {
+		newIter[i] = real(v)
+	}
+	return ToIterFloat64(newIter)
+}
+
+// FFT() returns a new IterableComplex128 with the discrete Fourier transform of the real samples
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) FFT() *IterableComplex128 {
+	return ToIterComplex128(fft(toComplex128(iter.List()), false))
+}
+
+// RFFT() returns a new IterableComplex128 with the len/2 + 1 non-negative frequency bins of the
+// discrete Fourier transform of the real samples (like numpy.fft.rfft), s. RFFTFreq for their frequencies
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RFFT() *IterableComplex128 {
+	spectrum := fft(toComplex128(iter.List()), false)
+	return ToIterComplex128(append(make([]complex128, 0, iter.Len/2+1), spectrum[:iter.Len/2+1]...))
+}
+
+// PowerSpectrum() returns a new iterable with the power |X[k]|² / len of the len/2 + 1 bins of RFFT
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) PowerSpectrum() *IterableFloat64 {
+	bins := iter.RFFT().List()
+	newIter := make([]float64, len(bins))
+	for i, v := range bins {
+		newIter[i] = (real(v)*real(v) + imag(v)*imag(v)) / float64(iter.Len)
+	}
+	return ToIterFloat64(newIter)
+}
+
+// FFTFreq(n, d) returns an iterable with the frequencies of the n bins of FFT for the sample spacing d
+// [0, 1, ..., n/2-1, -n/2, ..., -1] / (d*n) like numpy.fft.fftfreq
+func FFTFreq(n int, d float64) *IterableFloat64 {
+	if n < 1 {
+		panic(ERR_SHORTER1)
+	}
+	freq := make([]float64, n)
+	for i := range freq {
+		k := i
+		if i >= (n+1)/2 {
+			k = i - n
+		}
+		freq[i] = float64(k) / (d * float64(n))
+	}
+	return ToIterFloat64(freq)
+}
+
+// RFFTFreq(n, d) returns an iterable with the frequencies of the n/2 + 1 bins of RFFT for n samples
+// with the sample spacing d like numpy.fft.rfftfreq
+func RFFTFreq(n int, d float64) *IterableFloat64 {
+	if n < 1 {
+		panic(ERR_SHORTER1)
+	}
+	freq := make([]float64, n/2+1)
+	for i := range freq {
+		freq[i] = float64(i) / (d * float64(n))
+	}
+	return ToIterFloat64(freq)
+}
+
+// cosineWindow returns the symmetric window sum(a[j] * cos(2πjk/(n-1))) with alternating signs
+func cosineWindow(n int, a ...float64) *IterableFloat64 {
+	if n < 1 {
+		panic(ERR_SHORTER1)
+	}
+	w := make([]float64, n)
+	if n == 1 {
+		w[0] = 1
+		return ToIterFloat64(w)
+	}
+	for k := range w {
+		sign := 1.0
+		for j, aj := range a {
+			w[k] += sign * aj * math.Cos(2*math.Pi*float64(j*k)/float64(n-1))
+			sign = -sign
+		}
+	}
+	return ToIterFloat64(w)
+}
+
+// HannWindow(n) returns an iterable with the symmetric Hann window of length n (like numpy.hanning)
+func HannWindow(n int) *IterableFloat64 {
+	return cosineWindow(n, 0.5, 0.5)
+}
+
+// HammingWindow(n) returns an iterable with the symmetric Hamming window of length n (like numpy.hamming)
+func HammingWindow(n int) *IterableFloat64 {
+	return cosineWindow(n, 0.54, 0.46)
+}
+
+// BlackmanWindow(n) returns an iterable with the symmetric Blackman window of length n (like numpy.blackman)
+func BlackmanWindow(n int) *IterableFloat64 {
+	return cosineWindow(n, 0.42, 0.5, 0.08)
+}
+
+// Windowed(window) returns a new iterable with the samples multiplied by the window WINHANN, WINHAMMING
+// or WINBLACKMAN of the same length to be transformed by FFT, RFFT or PowerSpectrum
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Windowed(window int) *IterableFloat64 {
+	switch window {
+	case WINHANN:
+		return iter.Mul(HannWindow(iter.Len))
+	case WINHAMMING:
+		return iter.Mul(HammingWindow(iter.Len))
+	case WINBLACKMAN:
+		return iter.Mul(BlackmanWindow(iter.Len))
+	}
+	panic(ERR_METHOD)
+}
@@ -0,0 +1,113 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+// Float methods of IterableFloat64
+// The methods work on the underlying slice and change neither the slice nor the iterable's index.
+
+// absFloat64 returns the absolute value of x
+func absFloat64(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// twoSumFloat64 returns a + b and the rounding error of that sum (Neumaier); narrow rounds to float64
+func twoSumFloat64(a, b sumFloat64, narrow bool) (sumFloat64, sumFloat64) {
+	t := a + b
+	if narrow {
+		t = sumFloat64(float64(t))
+	}
+	if absFloat64(float64(a)) >= absFloat64(float64(b)) {
+		return t, (a - t) + b
+	}
+	return t, (b - t) + a
+}
+
+// SumKahan([acc64=false]) returns the sum of all elements using compensated summation
+// (second-order Kahan-Babuska-Neumaier after Klein, so the compensation itself is compensated too)
+// The rounding error does not grow with the length of the iterable as it does with Reduce(x + y)
+// With acc64 true the elements are accumulated in realFloat64 (matters for IterableFloat32 only)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) SumKahan(acc64 ...bool) sumFloat64 {
+	narrow := !(len(acc64) == 1 && acc64[0])
+	var sum, cs, ccs, c, cc sumFloat64
+	for _, v := range iter.List() {
+		sum, c = twoSumFloat64(sum, sumFloat64(v), narrow)
+		cs, cc = twoSumFloat64(cs, c, narrow)
+		ccs += cc
+		if narrow {
+			ccs = sumFloat64(float64(ccs))
+		}
+	}
+	if narrow {
+		return sumFloat64(float64(sum + (cs + ccs)))
+	}
+	return sum + (cs + ccs)
+}
+
+// pairwiseSumFloat64 adds up s by recursive halving; narrow rounds every step to float64
+func pairwiseSumFloat64(s []float64, narrow bool) sumFloat64 {
+	if len(s) <= PAIRWISEBLOCK {
+		var sum sumFloat64
+		for _, v := range s {
+			sum += sumFloat64(v)
+			if narrow {
+				sum = sumFloat64(float64(sum))
+			}
+		}
+		return sum
+	}
+	m := len(s) >> 1
+	sum := pairwiseSumFloat64(s[:m], narrow) + pairwiseSumFloat64(s[m:], narrow)
+	if narrow {
+		return sumFloat64(float64(sum))
+	}
+	return sum
+}
+
+// SumPairwise([acc64=false]) returns the sum of all elements using pairwise (cascade) summation
+// The rounding error grows with log(Len) instead of Len like with Reduce(x + y) and it is nearly as fast
+// With acc64 true the elements are accumulated in realFloat64 (matters for IterableFloat32 only)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) SumPairwise(acc64 ...bool) sumFloat64 {
+	return pairwiseSumFloat64(iter.List(), !(len(acc64) == 1 && acc64[0]))
+}
+
+// MeanStable() returns the arithmetic mean of all elements updating a running mean in realFloat64
+// Other than Mean it can neither overflow nor lose the small elements in a large sum
+// Does not change the underlying original slice
+func (iter *IterableFloat64) MeanStable() realFloat64 {
+	if iter.Len < 1 {
+		panic(ERR_SHORTER1)
+	}
+	var mean, comp realFloat64
+	for i, v := range iter.List() {
+		// compensated update mean += (v - mean) / (i+1)
+		y := (realFloat64(v)-mean)/realFloat64(i+1) - comp
+		t := mean + y
+		comp = (t - mean) - y
+		mean = t
+	}
+	return mean
+}
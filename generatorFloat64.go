@@ -0,0 +1,172 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Generators of float64 like Python's yield
+// A push function func(yield func(float64) bool) calls yield for every element and stops when yield returns false.
+// FromGeneratorFloat64 turns it into a pull iterator: the push function runs in a goroutine started with the
+// first pull and hands over one element per pull, so the elements are neither computed ahead nor stored.
+
+// GeneratorFloat64 is a pull iterator over the elements of a push function with the stepwise API of IterableFloat64
+// Its functions must not be called concurrently (use Pipe or TeeNext to share the elements)
+type GeneratorFloat64 struct {
+	// Next pulls the next element; behind the last element it returns the last one (like IterableFloat64.Next)
+	// This returns the element of the last pull (MINFLOAT64 before the first)
+	Next, This func() float64
+	// Index returns the number of pulled elements - 1 (-1 before the first pull, the number of elements when exhausted)
+	Index func() int
+	// MapNext and FilterNext return stepwise functions pulling from the current position (a generator can not reset)
+	MapNext    func(func(float64) float64) func() (float64, bool)
+	FilterNext func(func(float64) bool) func() (float64, bool)
+	// Close stops the generator (yield returns false) and releases its goroutine; an abandoned generator
+	// is closed when it is garbage collected
+	Close func()
+}
+
+// generatorFloat64 is the consumer's state of a GeneratorFloat64, the goroutine refers to the channels only,
+// so the state becomes unreachable (and closes the generator) when the consumer abandons it
+type generatorFloat64 struct {
+	values    chan float64
+	resume    chan struct{}
+	done      chan struct{}
+	panicked  chan interface{}
+	push      func(yield func(float64) bool)
+	closeOnce sync.Once
+	started   bool
+	finished  bool
+	this      float64
+	idx       int
+}
+
+// runGeneratorFloat64 runs push handing over one element per resume until push returns or done is closed
+func runGeneratorFloat64(push func(yield func(float64) bool), values chan<- float64, resume, done <-chan struct{}, panicked chan<- interface{}) {
+	defer close(values)
+	defer func() {
+		if p := recover(); p != nil {
+			panicked <- p
+		}
+	}()
+	select {
+	case <-resume:
+	case <-done:
+		return
+	}
+	push(func(v float64) bool {
+		select {
+		case values <- v:
+		case <-done:
+			return false
+		}
+		select {
+		case <-resume:
+			return true
+		case <-done:
+			return false
+		}
+	})
+}
+
+// pull returns the next element of the generator and a bool indicator for the exhaustion
+// A panic of the push function is raised again in the consumer
+func (g *generatorFloat64) pull() (float64, bool) {
+	if g.finished {
+		return MINFLOAT64, true
+	}
+	if !g.started {
+		g.started = true
+		go runGeneratorFloat64(g.push, g.values, g.resume, g.done, g.panicked)
+	}
+	g.resume <- struct{}{}
+	v, ok := <-g.values
+	if !ok {
+		g.finished = true
+		g.idx++
+		select {
+		case p := <-g.panicked:
+			panic(p)
+		default:
+		}
+		return MINFLOAT64, true
+	}
+	g.idx++
+	g.this = v
+	return v, false
+}
+
+// close stops the generator and lets its goroutine exit
+func (g *generatorFloat64) close() {
+	g.closeOnce.Do(func() { close(g.done) })
+	if !g.finished {
+		g.finished = true
+		g.idx++
+	}
+}
+
+// FromGeneratorFloat64(push) returns a GeneratorFloat64 pulling the elements from the push function
+// Close it if it is not read to the end
+// Uses a goroutine from the first pull until push returns or the generator is closed
+func FromGeneratorFloat64(push func(yield func(float64) bool)) *GeneratorFloat64 {
+	g := &generatorFloat64{
+		values:   make(chan float64),
+		resume:   make(chan struct{}),
+		done:     make(chan struct{}),
+		panicked: make(chan interface{}, 1),
+		push:     push,
+		this:     MINFLOAT64,
+		idx:      -1,
+	}
+	runtime.SetFinalizer(g, (*generatorFloat64).close)
+
+	var gen GeneratorFloat64
+	gen.Next = func() float64 {
+		g.pull()
+		return g.this
+	}
+	gen.This = func() float64 { return g.this }
+	gen.Index = func() int { return g.idx }
+	gen.MapNext = func(fn func(float64) float64) func() (float64, bool) {
+		return func() (float64, bool) {
+			v, exhausted := g.pull()
+			if exhausted {
+				return v, true
+			}
+			return fn(v), false
+		}
+	}
+	gen.FilterNext = func(cond func(float64) bool) func() (float64, bool) {
+		return func() (float64, bool) {
+			for v, exhausted := g.pull(); !exhausted; v, exhausted = g.pull() {
+				if cond(v) {
+					return v, false
+				}
+			}
+			return MINFLOAT64, true
+		}
+	}
+	gen.Close = g.close
+	return &gen
+}
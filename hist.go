@@ -0,0 +1,114 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"math"
+	"sort"
+)
+
+// histEdges returns bins + 1 equally spaced edges from lo to hi (lo - 0.5 to hi + 0.5 if lo == hi like numpy)
+func histEdges(lo, hi float64, bins int) *IterableFloat64 {
+	if bins < 1 {
+		panic(ERR_BINS)
+	}
+	if lo == hi {
+		lo, hi = lo-0.5, hi+0.5
+	}
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = lo + (hi-lo)*float64(i)/float64(bins)
+	}
+	edges[bins] = hi
+	return ToIterFloat64(edges)
+}
+
+// checkEdges panics if there are less than 2 edges or they are not increasing
+func checkEdges(edges []float64) {
+	if len(edges) < 2 {
+		panic(ERR_SHORTER2)
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i] > edges[i-1]) {
+			panic(ERR_NOTSORTED)
+		}
+	}
+}
+
+// binIndex returns the bin [edges[i], edges[i+1]) of x (the last bin includes its right edge) or -1 if x is outside
+func binIndex(edges []float64, x float64) int {
+	last := len(edges) - 1
+	if x == edges[last] {
+		return last - 1
+	}
+	i := sort.Search(len(edges), func(k int) bool { return edges[k] > x }) - 1
+	if i < 0 || i >= last {
+		return -1
+	}
+	return i
+}
+
+// digitize returns i with edges[i-1] <= x < edges[i] (edges[i-1] < x <= edges[i] if right) like numpy.digitize
+func digitize(edges []float64, x float64, right bool) int {
+	if right {
+		return sort.Search(len(edges), func(k int) bool { return edges[k] >= x })
+	}
+	return sort.Search(len(edges), func(k int) bool { return edges[k] > x })
+}
+
+// qcutEdges returns q + 1 edges at the quantiles 0, 1/q ... 1 of n sorted values (at(i) returns the i-th)
+func qcutEdges(n int, at func(int) float64, q int) *IterableFloat64 {
+	if q < 1 {
+		panic(ERR_BINS)
+	}
+	if n < 1 {
+		panic(ERR_SHORTER1)
+	}
+	edges := make([]float64, q+1)
+	for i := range edges {
+		edges[i] = quantile(n, at, float64(i)/float64(q), QLINEAR)
+	}
+	return ToIterFloat64(edges)
+}
+
+// HistDensity(counts, edges) returns the probability densities count / (total * width) of the bins of a histogram,
+// so that the integral over the bins is 1 (like numpy.histogram with density=True)
+// Panics with ERR_DIFFLEN if there is not one edge more than counts
+func HistDensity(counts *IterableInt, edges *IterableFloat64) *IterableFloat64 {
+	c, e := counts.List(), edges.List()
+	if len(e) != len(c)+1 {
+		panic(ERR_DIFFLEN)
+	}
+	total := 0
+	for _, v := range c {
+		total += v
+	}
+	density := make([]float64, len(c))
+	for i, v := range c {
+		if total == 0 {
+			density[i] = math.NaN()
+			continue
+		}
+		density[i] = float64(v) / float64(total) / (e[i+1] - e[i])
+	}
+	return ToIterFloat64(density)
+}
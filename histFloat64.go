@@ -0,0 +1,123 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "math"
+
+// Histograms and binning of IterableFloat64
+// The bin edges are always float (realIterFloat64), counts and bin indices are IterableInt
+
+// Histogram([bins=HISTBINS]) returns the counts of the elements in bins equally spaced bins from the
+// smallest to the largest element and the bins + 1 edges (like numpy.histogram)
+// The bins are [edges[i], edges[i+1]), the last bin includes the largest element; NaN elements are not counted
+// Panics with ERR_SHORTER1 if all elements are NaN
+// Use HistDensity(counts, edges) for the probability densities
+// Uses memory (new slices for counts and edges)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Histogram(bins ...int) (*IterableInt, *realIterFloat64) {
+	b := HISTBINS
+	if len(bins) == 1 {
+		b = bins[0]
+	}
+	lo, hi := realFloat64(math.NaN()), realFloat64(math.NaN())
+	for _, v := range iter.List() {
+		x := realFloat64(v)
+		if x < lo || math.IsNaN(lo) {
+			lo = x
+		}
+		if x > hi || math.IsNaN(hi) {
+			hi = x
+		}
+	}
+	if math.IsNaN(lo) {
+		panic(ERR_SHORTER1)
+	}
+	edges := histEdges(lo, hi, b)
+	return iter.HistogramEdges(edges), edges
+}
+
+// HistogramEdges(edges) returns the counts of the elements in the bins [edges[i], edges[i+1]) (the last bin
+// includes its right edge); elements outside of the edges are not counted
+// Panics with ERR_NOTSORTED if the edges are not increasing
+// Uses memory (new []int slice with len(edges) - 1)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) HistogramEdges(edges *realIterFloat64) *IterableInt {
+	e := edges.List()
+	checkEdges(e)
+	counts := make([]int, len(e)-1)
+	for _, v := range iter.List() {
+		if i := binIndex(e, realFloat64(v)); i >= 0 {
+			counts[i]++
+		}
+	}
+	return ToIterInt(counts)
+}
+
+// Digitize(edges, [right=false]) returns an *IterableInt with the bin index of every element (like numpy.digitize):
+// i with edges[i-1] <= x < edges[i] (edges[i-1] < x <= edges[i] if right), 0 below and len(edges) above the edges
+// Panics with ERR_NOTSORTED if the edges are not increasing
+// Uses memory (new []int slice with the originals length)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Digitize(edges *realIterFloat64, right ...bool) *IterableInt {
+	e := edges.List()
+	checkEdges(e)
+	r := len(right) == 1 && right[0]
+	indices := make([]int, iter.Len)
+	for i, v := range iter.List() {
+		indices[i] = digitize(e, realFloat64(v), r)
+	}
+	return ToIterInt(indices)
+}
+
+// QCut(q) bins the elements into q quantile-based bins of (about) equal size (like pandas.qcut) and returns
+// an *IterableInt with the bin (0..q-1) of every element and the q + 1 edges at the quantiles 0, 1/q ... 1
+// The bins are (edges[i], edges[i+1]], the first bin includes the smallest element;
+// repeated elements can make edges equal and leave bins empty
+// NaN elements are left out of the quantiles and get the bin -1 (like Histogram leaves them uncounted)
+// Panics with ERR_SHORTER1 if all elements are NaN
+// Uses memory (sorted copy and new slices for bins and edges)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) QCut(q int) (*IterableInt, *realIterFloat64) {
+	sorted := sortedFloat64(iter.List())
+	n := len(sorted)
+	for n > 0 && math.IsNaN(realFloat64(sorted[n-1])) {
+		n--
+	}
+	edges := qcutEdges(n, func(i int) realFloat64 { return realFloat64(sorted[i]) }, q)
+	e := edges.List()
+	bins := make([]int, iter.Len)
+	for i, v := range iter.List() {
+		if math.IsNaN(realFloat64(v)) {
+			bins[i] = -1
+			continue
+		}
+		b := digitize(e, realFloat64(v), true) - 1
+		if b < 0 {
+			b = 0
+		}
+		if b > q-1 {
+			b = q - 1
+		}
+		bins[i] = b
+	}
+	return ToIterInt(bins), edges
+}
@@ -0,0 +1,215 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "sort"
+
+// Interpolation and resampling of IterableFloat64
+// The functions and methods do not change the underlying original slices and return new iterables.
+
+// splineFloat64 returns the second derivatives of the natural cubic spline through (xs, ys)
+func splineFloat64(xs, ys []float64) []realFloat64 {
+	n := len(xs)
+	m := make([]realFloat64, n)
+	if n < 3 {
+		return m
+	}
+	// tridiagonal system for m[1..n-2] with m[0] = m[n-1] = 0 (Thomas algorithm)
+	c := make([]realFloat64, n)
+	d := make([]realFloat64, n)
+	for i := 1; i < n-1; i++ {
+		h0 := realFloat64(xs[i]) - realFloat64(xs[i-1])
+		h1 := realFloat64(xs[i+1]) - realFloat64(xs[i])
+		rhs := 6 * ((realFloat64(ys[i+1])-realFloat64(ys[i]))/h1 - (realFloat64(ys[i])-realFloat64(ys[i-1]))/h0)
+		diag := 2*(h0+h1) - h0*c[i-1]
+		c[i] = h1 / diag
+		d[i] = (rhs - h0*d[i-1]) / diag
+	}
+	for i := n - 2; i > 0; i-- {
+		m[i] = d[i] - c[i]*m[i+1]
+	}
+	return m
+}
+
+// InterpFloat64(xs, ys, xNew, [method=INTERPLINEAR]) returns a new iterable with the values at the positions xNew
+// of the function sampled at the increasing positions xs with the values ys (like numpy.interp)
+// method INTERPLINEAR, INTERPNEAREST, INTERPSTEP (previous sample) or INTERPCUBIC (natural cubic spline)
+// Positions outside of xs get the first or last value of ys, NaN positions get NaN
+// Returns ErrDiffLen if xs and ys differ in length, ErrShorter2 for less than 2 samples
+// and ErrNotSorted if xs is not increasing (or has a NaN)
+// Uses memory (new slice with the dimensions of xNew) and the new iterable refers to this new slice
+func InterpFloat64(xs, ys, xNew *IterableFloat64, method ...int) (*IterableFloat64, error) {
+	if xs.Len != ys.Len {
+		return nil, ErrDiffLen
+	}
+	if xs.Len < 2 {
+		return nil, ErrShorter2
+	}
+	x, y := xs.List(), ys.List()
+	for i := 1; i < len(x); i++ {
+		if !(x[i] > x[i-1]) {
+			return nil, ErrNotSorted
+		}
+	}
+	m := INTERPLINEAR
+	if len(method) == 1 {
+		m = method[0]
+	}
+	var m2 []realFloat64
+	switch m {
+	case INTERPCUBIC:
+		m2 = splineFloat64(x, y)
+	case INTERPLINEAR, INTERPNEAREST, INTERPSTEP:
+	default:
+		panic(ERR_METHOD)
+	}
+	last := len(x) - 1
+	newIter := make([]float64, xNew.Len)
+	for i, v := range xNew.List() {
+		switch {
+		case isNaNFloat64(v):
+			newIter[i] = v
+			continue
+		case v <= x[0]:
+			newIter[i] = y[0]
+			continue
+		case v >= x[last]:
+			newIter[i] = y[last]
+			continue
+		}
+		// x[j-1] < v <= x[j]
+		j := sort.Search(len(x), func(k int) bool { return x[k] >= v })
+		if x[j] == v {
+			newIter[i] = y[j]
+			continue
+		}
+		x0, x1 := realFloat64(x[j-1]), realFloat64(x[j])
+		y0, y1 := realFloat64(y[j-1]), realFloat64(y[j])
+		h, t := x1-x0, realFloat64(v)-x0
+		switch m {
+		case INTERPLINEAR:
+			newIter[i] = float64(y0 + (y1-y0)*t/h)
+		case INTERPNEAREST:
+			newIter[i] = y[j]
+			if t < h-t {
+				newIter[i] = y[j-1]
+			}
+		case INTERPSTEP:
+			newIter[i] = y[j-1]
+		case INTERPCUBIC:
+			a, b := (x1-realFloat64(v))/h, t/h
+			newIter[i] = float64(a*y0 + b*y1 + ((a*a*a-a)*m2[j-1]+(b*b*b-b)*m2[j])*h*h/6)
+		}
+	}
+	return ToIterFloat64(newIter), nil
+}
+
+// Resample(n) returns a new iterable with n samples linearly interpolated over the equally spaced elements
+// (first and last element are kept) to up- or downsample the series
+// Uses memory (new slice with length n) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Resample(n int) *IterableFloat64 {
+	if n < 2 || iter.Len < 2 {
+		panic(ERR_SHORTER2)
+	}
+	s := iter.List()
+	newIter := make([]float64, n)
+	step := realFloat64(len(s)-1) / realFloat64(n-1)
+	for i := range newIter {
+		pos := realFloat64(i) * step
+		j := int(pos)
+		if j >= len(s)-1 {
+			newIter[i] = s[len(s)-1]
+			continue
+		}
+		frac := pos - realFloat64(j)
+		newIter[i] = float64(realFloat64(s[j]) + (realFloat64(s[j+1])-realFloat64(s[j]))*frac)
+	}
+	return ToIterFloat64(newIter)
+}
+
+// lttbFloat64 returns the indices of n points of (x(i), y[i]) picked by largest-triangle-three-buckets
+func lttbFloat64(x func(int) realFloat64, y []float64, n int) *IterableInt {
+	if n < 3 {
+		panic(ERR_THRESHOLD)
+	}
+	indices := make([]int, 0, n)
+	if n >= len(y) {
+		for i := range y {
+			indices = append(indices, i)
+		}
+		return ToIterInt(indices)
+	}
+	every := realFloat64(len(y)-2) / realFloat64(n-2)
+	a := 0
+	indices = append(indices, a)
+	for i := 0; i < n-2; i++ {
+		// average point of the next bucket
+		avgStart, avgEnd := int(realFloat64(i+1)*every)+1, int(realFloat64(i+2)*every)+1
+		if avgEnd > len(y) {
+			avgEnd = len(y)
+		}
+		var avgX, avgY realFloat64
+		for j := avgStart; j < avgEnd; j++ {
+			avgX += x(j)
+			avgY += realFloat64(y[j])
+		}
+		avgX /= realFloat64(avgEnd - avgStart)
+		avgY /= realFloat64(avgEnd - avgStart)
+		// point of this bucket with the largest triangle to a and the average
+		ax, ay := x(a), realFloat64(y[a])
+		maxArea, next := -1.0, a
+		for j := int(realFloat64(i)*every) + 1; j < avgStart; j++ {
+			area := (ax-avgX)*(realFloat64(y[j])-ay) - (ax-x(j))*(avgY-ay)
+			if area < 0 {
+				area = -area
+			}
+			if area > maxArea {
+				maxArea, next = area, j
+			}
+		}
+		a = next
+		indices = append(indices, a)
+	}
+	indices = append(indices, len(y)-1)
+	return ToIterInt(indices)
+}
+
+// LTTB(n) downsamples the series (with the positions 0, 1, 2 ...) to n >= 3 points for plotting by
+// the largest-triangle-three-buckets algorithm and returns an *IterableInt with their indices
+// (like Where, use them to pick the elements); all indices if n >= len
+// Uses memory (new []int slice with length n)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) LTTB(n int) *IterableInt {
+	return lttbFloat64(func(i int) realFloat64 { return realFloat64(i) }, iter.List(), n)
+}
+
+// LTTBFloat64(xs, ys, n) downsamples the points (xs[i], ys[i]) to n >= 3 points by the
+// largest-triangle-three-buckets algorithm and returns an *IterableInt with their indices (s. LTTB)
+// Returns ErrDiffLen if xs and ys differ in length
+func LTTBFloat64(xs, ys *IterableFloat64, n int) (*IterableInt, error) {
+	if xs.Len != ys.Len {
+		return nil, ErrDiffLen
+	}
+	x := xs.List()
+	return lttbFloat64(func(i int) realFloat64 { return realFloat64(x[i]) }, ys.List(), n), nil
+}
@@ -27,6 +27,7 @@ package itertools
 import (
 	"fmt"
 	// "math/rand"
+	"sort"
 	"strings"
 	"testing"
 	// "time"
@@ -541,6 +542,111 @@ func Benchmark_IterFloat64_Tee5(b *testing.B) {
 	}
 }
 
+// sum, dot, scale and axpy: Reduce / MMap / MapInto vs. the numeric methods vs. the fast kernels
+
+var benchSink float64
+
+func Benchmark_IterFloat64_Sum_Reduce(b *testing.B) {
+	seq := ToIterFloat64(f1)
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = seq.Reduce(func(x, y float64) float64 { return x + y })
+	}
+}
+
+func Benchmark_IterFloat64_Sum(b *testing.B) {
+	seq := ToIterFloat64(f1)
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = seq.Sum()
+	}
+}
+
+func Benchmark_IterFloat64_SumFast(b *testing.B) {
+	seq := ToIterFloat64(f1)
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = seq.SumFast()
+	}
+}
+
+func Benchmark_IterFloat64_Dot_MMapReduce(b *testing.B) {
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = MMapToIterFloat64(func(v []float64) float64 { return v[0] * v[1] }, f1, f2).Reduce(func(x, y float64) float64 { return x + y })
+	}
+}
+
+func Benchmark_IterFloat64_Dot(b *testing.B) {
+	seq1, seq2 := ToIterFloat64(f1), ToIterFloat64(f2)
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = seq1.Dot(seq2)
+	}
+}
+
+func Benchmark_IterFloat64_DotFast(b *testing.B) {
+	seq1, seq2 := ToIterFloat64(f1), ToIterFloat64(f2)
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = seq1.DotFast(seq2)
+	}
+}
+
+func Benchmark_IterFloat64_Scale_MapInto(b *testing.B) {
+	seq := ToIterFloat64(f1)
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		seq.MapInto(func(x float64) float64 { return x * 1.0000001 })
+	}
+	b.StopTimer()
+	copy(f1, f2)
+}
+
+func Benchmark_IterFloat64_Scale(b *testing.B) {
+	seq := ToIterFloat64(f1)
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		seq.Scale(1.0000001)
+	}
+	b.StopTimer()
+	copy(f1, f2)
+}
+
+func Benchmark_IterFloat64_AXPY(b *testing.B) {
+	seq1, seq2 := ToIterFloat64(f1), ToIterFloat64(f2)
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		seq1.AXPY(1e-9, seq2)
+	}
+	b.StopTimer()
+	copy(f1, f2)
+}
+
+func Benchmark_IterFloat32_Sum(b *testing.B) {
+	seq := ToIterFloat32(make([]float32, SAMPLELEN))
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = seq.Sum()
+	}
+}
+
+func Benchmark_IterFloat32_SumFast(b *testing.B) {
+	seq := ToIterFloat32(make([]float32, SAMPLELEN))
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = float64(seq.SumFast())
+	}
+}
+
+func Benchmark_IterFloat32_DotFast(b *testing.B) {
+	seq := ToIterFloat32(make([]float32, SAMPLELEN))
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		benchSink = float64(seq.DotFast(seq))
+	}
+}
+
 // func Benchmark_IterFloat64_MMap(b *testing.B) {
 //     mapFn := func(iterVal []float64) float64 {
 //         return iterVal[0] + iterVal[1] - iterVal[2]
@@ -720,3 +826,45 @@ func Benchmark_IterIf_Tee5(b *testing.B) {
 // 		v = v
 // 	}
 // }
+
+func Benchmark_IterInt64_Accumulate(b *testing.B) {
+	seq := ToIterInt64(li1)
+	add := func(x, y int64) int64 { return x + y }
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		seq.Accumulate(add)
+	}
+}
+
+func Benchmark_IterInt64_ParallelAccumulate(b *testing.B) {
+	seq := ToIterInt64(li1)
+	add := func(x, y int64) int64 { return x + y }
+	b.ResetTimer()
+	for r := 0; r < b.N; r++ {
+		seq.ParallelAccumulate(add)
+	}
+}
+
+func Benchmark_IterInt64_SortSliceStable(b *testing.B) {
+	for r := 0; r < b.N; r++ {
+		b.StopTimer()
+		copy(li1, li2)
+		b.StartTimer()
+		sort.SliceStable(li1, func(i, j int) bool { return li1[i] < li1[j] })
+	}
+	b.StopTimer()
+	copy(li1, li2)
+}
+
+func Benchmark_IterInt64_ParallelSortInto(b *testing.B) {
+	seq := ToIterInt64(li1)
+	less := func(x, y int64) bool { return x < y }
+	for r := 0; r < b.N; r++ {
+		b.StopTimer()
+		copy(li1, li2)
+		b.StartTimer()
+		seq.ParallelSortInto(less)
+	}
+	b.StopTimer()
+	copy(li1, li2)
+}
@@ -23,10 +23,17 @@
 package itertools
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math"
+	"math/cmplx"
 	"math/rand"
+	"runtime"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -812,3 +819,1575 @@ func ExampleIterIfTee() {
 	// Output: [0 1 2 3 4 5 6 7 8 9][9 8 7 6 5 4 3 2 1 0]
 	// [0 1 2 3 4 5 6][7 8 9 9 8 7 6][5 4 3 2 1 0]
 }
+
+// numeric methods
+
+func TestNumericIterInt(t *testing.T) {
+	seq := ToIterInt(l1)
+	if sum := seq.Sum(); sum != lsum {
+		t.Errorf("Sum: is %v != should %v", sum, lsum)
+	}
+	if mean := seq.Mean(); mean != float64(lsum)/SAMPLELEN {
+		t.Errorf("Mean: is %v != should %v", mean, float64(lsum)/SAMPLELEN)
+	}
+	min, max := seq.MinMax()
+	if min != seq.Min() || max != seq.Max() ||
+		min != l1[seq.ArgMin()] || max != l1[seq.ArgMax()] {
+		t.Errorf("MinMax: (%v, %v) differs from Min %v (idx %v) / Max %v (idx %v)", min, max, seq.Min(), seq.ArgMin(), seq.Max(), seq.ArgMax())
+	}
+	for i, v := range l1 {
+		if v < min || v > max {
+			t.Errorf("MinMax: element %3d = %v is out of range [%v, %v]", i, v, min, max)
+		}
+	}
+	if seq.Index() != -1 {
+		t.Errorf("numeric methods changed the index to %v", seq.Index())
+	}
+}
+
+func TestNumericNaNFloat64(t *testing.T) {
+	// NaN propagates wherever it is, NanMin and NanMax leave it out
+	for _, at := range []int{0, 2, 4} {
+		data := []float64{3, 1, 4, 5, 2}
+		data[at] = math.NaN()
+		seq := ToIterFloat64(data)
+		min, max := seq.MinMax()
+		if !math.IsNaN(seq.Min()) || !math.IsNaN(seq.Max()) || !math.IsNaN(min) || !math.IsNaN(max) {
+			t.Errorf("NaN at %v: Min %v, Max %v, MinMax (%v, %v) != should NaN", at, seq.Min(), seq.Max(), min, max)
+		}
+		if seq.ArgMin() != at || seq.ArgMax() != at {
+			t.Errorf("NaN at %v: ArgMin %v, ArgMax %v != should %v", at, seq.ArgMin(), seq.ArgMax(), at)
+		}
+		if math.IsNaN(seq.NanMin()) || math.IsNaN(seq.NanMax()) {
+			t.Errorf("NaN at %v: NanMin %v, NanMax %v", at, seq.NanMin(), seq.NanMax())
+		}
+	}
+}
+
+func TestNumericSmallTypesOverflow(t *testing.T) {
+	seq8 := ToIterInt8([]int8{127, 127, 127, 127})
+	if sum := seq8.Sum(); sum != 508 {
+		t.Errorf("Sum IterableInt8: is %v != should 508", sum)
+	}
+	if prod := seq8.Product(); prod != 127*127*127*127 {
+		t.Errorf("Product IterableInt8: is %v != should %v", prod, 127*127*127*127)
+	}
+	seqB := ToIterByte([]byte{255, 255, 2})
+	if sum := seqB.Sum(); sum != 512 {
+		t.Errorf("Sum IterableByte: is %v != should 512", sum)
+	}
+	if mean := seqB.Mean(); mean != 512.0/3 {
+		t.Errorf("Mean IterableByte: is %v != should %v", mean, 512.0/3)
+	}
+}
+
+func ExampleIterableFloat64_Sum() {
+	seq := ToIterFloat64([]float64{3, 1, 4, 1, 5, 9, 2, 6})
+	fmt.Println(seq.Sum(), seq.Mean(), seq.Product())
+	fmt.Println(seq.Min(), seq.ArgMin(), seq.Max(), seq.ArgMax())
+	fmt.Println(seq.MinMax())
+	// Output: 31 3.875 6480
+	// 1 1 9 5
+	// 1 9
+}
+
+func TestCompensatedSumFloat32(t *testing.T) {
+	tenth := make([]float32, SAMPLELEN)
+	for i := range tenth {
+		tenth[i] = 0.1
+	}
+	seq := ToIterFloat32(tenth)
+	exact := float64(float32(0.1)) * SAMPLELEN
+	errOf := func(sum float64) float64 { return math.Abs(sum - exact) }
+
+	errReduce := errOf(float64(seq.Reduce(func(x, y float32) float32 { return x + y })))
+	ulp := float64(math.Nextafter32(float32(exact), float32(math.Inf(1))) - float32(exact))
+	if e := errOf(seq.SumKahan()); e > 2*ulp || e >= errReduce {
+		t.Errorf("SumKahan: error %v (Reduce error %v, ulp %v)", e, errReduce, ulp)
+	}
+	if e := errOf(seq.SumPairwise()); e > 16*ulp || e >= errReduce {
+		t.Errorf("SumPairwise: error %v (Reduce error %v, ulp %v)", e, errReduce, ulp)
+	}
+	if e := errOf(seq.SumKahan(true)); e > 1e-9 {
+		t.Errorf("SumKahan(acc64): error %v", e)
+	}
+	if e := errOf(seq.SumPairwise(true)); e > 1e-9 {
+		t.Errorf("SumPairwise(acc64): error %v", e)
+	}
+	if e := math.Abs(seq.MeanStable() - float64(float32(0.1))); e > 1e-15 {
+		t.Errorf("MeanStable: error %v", e)
+	}
+}
+
+func ExampleIterableFloat64_SumKahan() {
+	seq := ToIterFloat64([]float64{1e100, 1.0, -1e100})
+	fmt.Println(seq.Reduce(func(x, y float64) float64 { return x + y }), seq.SumKahan())
+	// Output: 0 1
+}
+
+func TestDescribeFloat64(t *testing.T) {
+	d := ToIterFloat64([]float64{2, 4, 4, 4, 5, 5, 7, 9}).Describe()
+	should := Description{Count: 8, Mean: 5, Variance: 32.0 / 7, StdDev: math.Sqrt(32.0 / 7),
+		Min: 2, Q25: 4, Median: 4.5, Q75: 5.5, Max: 9, Skewness: 0.65625, Kurtosis: -0.21875}
+	if d.Count != should.Count {
+		t.Errorf("Describe Count: is %v != should %v", d.Count, should.Count)
+	}
+	for _, c := range []struct {
+		name      string
+		is, shall float64
+	}{
+		{"Mean", d.Mean, should.Mean}, {"Variance", d.Variance, should.Variance}, {"StdDev", d.StdDev, should.StdDev},
+		{"Min", d.Min, should.Min}, {"Q25", d.Q25, should.Q25}, {"Median", d.Median, should.Median},
+		{"Q75", d.Q75, should.Q75}, {"Max", d.Max, should.Max},
+		{"Skewness", d.Skewness, should.Skewness}, {"Kurtosis", d.Kurtosis, should.Kurtosis},
+	} {
+		if math.Abs(c.is-c.shall) > 1e-12 {
+			t.Errorf("Describe %v: is %v != should %v", c.name, c.is, c.shall)
+		}
+	}
+
+	// Welford on the large sample
+	seq := ToIterFloat64(f1)
+	naive := 0.0
+	for _, v := range f1 {
+		naive += (v - fmean) * (v - fmean)
+	}
+	if v := seq.Variance(0); math.Abs(v-naive/SAMPLELEN) > 1e-9 {
+		t.Errorf("Variance: is %v != should %v", v, naive/SAMPLELEN)
+	}
+}
+
+func ExampleIterableInt_Quantile() {
+	seq := ToIterInt([]int{5, 1, 4, 2, 3})
+	for _, method := range []int{QLINEAR, QLOWER, QHIGHER, QNEAREST, QMIDPOINT} {
+		fmt.Printf("%v ", seq.Quantile(0.375, method))
+	}
+	fmt.Println(seq.Median(), seq.List())
+	// Output: 2.5 2 3 3 2.5 3 [5 1 4 2 3]
+}
+
+func TestCorrelationFloat64(t *testing.T) {
+	x := ToIterFloat64([]float64{1, 2, 3, 4, 5})
+	y := ToIterFloat64([]float64{2, 4, 5, 4, 5})
+	if _, err := PearsonFloat64(x, ToIterFloat64([]float64{1, 2})); err != ErrDiffLen {
+		t.Errorf("Pearson: different length should return ErrDiffLen not %v", err)
+	}
+	if _, err := CovarianceFloat64(ToIterFloat64([]float64{1}), ToIterFloat64([]float64{1})); err != ErrShorter2 {
+		t.Errorf("Covariance: length 1 should return ErrShorter2 not %v", err)
+	}
+	cov, _ := CovarianceFloat64(x, y)
+	r, _ := PearsonFloat64(x, y)
+	rho, _ := SpearmanFloat64(x, y)
+	slope, intercept, r2, err := LinearFitFloat64(x, y)
+	for _, c := range []struct {
+		name      string
+		is, shall float64
+	}{
+		{"Covariance", cov, 1.5}, {"Pearson", r, 6 / math.Sqrt(60)}, {"Spearman", rho, 7 / math.Sqrt(90)},
+		{"LinearFit slope", slope, 0.6}, {"LinearFit intercept", intercept, 2.2}, {"LinearFit r2", r2, 0.6},
+	} {
+		if math.Abs(c.is-c.shall) > 1e-12 {
+			t.Errorf("%v: is %v != should %v", c.name, c.is, c.shall)
+		}
+	}
+	if err != nil {
+		t.Errorf("LinearFit: unexpected error %v", err)
+	}
+}
+
+func ExampleLinearFitFloat64() {
+	x := ToIterFloat64([]float64{0, 1, 2, 3, 4})
+	y := x.Map(func(x float64) float64 { return 3*x - 1 })
+	slope, intercept, r2, err := LinearFitFloat64(x, y)
+	fmt.Println(slope, intercept, r2, err)
+	_, _, _, err = LinearFitFloat64(x, ToIterFloat64([]float64{1, 2, 3}))
+	fmt.Println(err)
+	// Output: 3 -1 1 <nil>
+	// Parameter error: underlying slices differ in length
+}
+
+func TestRollingFloat64(t *testing.T) {
+	const window = 7
+	seq := ToIterFloat64(f1[:10000])
+	sum, mean, std := seq.RollingSum(window).List(), seq.RollingMean(window).List(), seq.RollingStd(window).List()
+	min, max := seq.RollingMin(window).List(), seq.RollingMax(window).List()
+	if len(sum) != seq.Len-window+1 {
+		t.Errorf("RollingSum: length is %v != should %v", len(sum), seq.Len-window+1)
+	}
+	for i := range sum {
+		w := ToIterFloat64(f1[i : i+window])
+		wMin, wMax := w.MinMax()
+		if math.Abs(sum[i]-w.Sum()) > 1e-12 || math.Abs(mean[i]-w.Mean()) > 1e-12 ||
+			math.Abs(std[i]-w.StdDev()) > 1e-9 || min[i] != wMin || max[i] != wMax {
+			t.Errorf("Rolling window %v: sum %v mean %v std %v min %v max %v != should %v %v %v %v %v",
+				i, sum[i], mean[i], std[i], min[i], max[i], w.Sum(), w.Mean(), w.StdDev(), wMin, wMax)
+			break
+		}
+	}
+	if seq.Index() != -1 {
+		t.Errorf("Rolling methods changed the index to %v", seq.Index())
+	}
+}
+
+func TestRollingNonFiniteFloat64(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	// a non-finite element spoils its windows only, the windows behind it are finite again
+	seq := ToIterFloat64([]float64{1, nan, 2, 3, 4, 5})
+	should := map[string]string{
+		"sum":  "[NaN NaN 5 7 9]",
+		"mean": "[NaN NaN 2.5 3.5 4.5]",
+		"min":  "[NaN NaN 2 3 4]",
+		"max":  "[NaN NaN 3 4 5]",
+	}
+	got := map[string]string{
+		"sum":  fmt.Sprint(seq.RollingSum(2).List()),
+		"mean": fmt.Sprint(seq.RollingMean(2).List()),
+		"min":  fmt.Sprint(seq.RollingMin(2).List()),
+		"max":  fmt.Sprint(seq.RollingMax(2).List()),
+	}
+	for k, v := range should {
+		if got[k] != v {
+			t.Errorf("Rolling %v with NaN: %v != should %v", k, got[k], v)
+		}
+	}
+	if std := seq.RollingStd(2).List(); !math.IsNaN(std[1]) || math.Abs(std[2]-math.Sqrt(0.5)) > 1e-15 {
+		t.Errorf("RollingStd with NaN: %v", std)
+	}
+	seq = ToIterFloat64([]float64{1, inf, 2, -inf, 3, 4})
+	if got := fmt.Sprint(seq.RollingSum(2).List()); got != "[+Inf +Inf -Inf -Inf 7]" {
+		t.Errorf("RollingSum with Inf: %v != should [+Inf +Inf -Inf -Inf 7]", got)
+	}
+	if got := fmt.Sprint(seq.RollingMean(3).List()); got != "[+Inf NaN -Inf -Inf]" {
+		t.Errorf("RollingMean with Inf: %v != should [+Inf NaN -Inf -Inf]", got)
+	}
+	if got := fmt.Sprint(seq.RollingStd(2).List()); got != "[NaN NaN NaN NaN 0.7071067811865476]" {
+		t.Errorf("RollingStd with Inf: %v", got)
+	}
+}
+
+func ExampleIterableFloat64_RollingMean() {
+	seq := ToIterFloat64([]float64{1, 3, 2, 6, 4})
+	fmt.Println(seq.RollingMean(3).List(), seq.RollingMax(3).List())
+	for step, v, ex := seq.RollingMinNext(2), 0.0, false; ; {
+		v, ex = step()
+		if ex {
+			break
+		}
+		fmt.Printf("%v ", v)
+	}
+	fmt.Println(seq.EWMA(0.5).List())
+	// Output: [2 3.6666666666666665 4] [3 6 6]
+	// 1 2 2 4 [1 2 2 4 4]
+}
+
+func TestSimpsonFloat64(t *testing.T) {
+	square := func(x float64) float64 { return x * x }
+	// Simpson's rule is exact for quadratics, for even and odd numbers of intervals and irregular spacing
+	for _, xs := range [][]float64{{0, 1, 2, 3, 4}, {0, 1, 2, 3}, {0, 0.5, 2, 3}, {0, 0.2, 0.3, 1.7, 2, 3}} {
+		x := ToIterFloat64(xs)
+		y := x.Map(square)
+		last := xs[len(xs)-1]
+		integral, err := y.SimpsonX(x)
+		if err != nil || math.Abs(integral-last*last*last/3) > 1e-12 {
+			t.Errorf("SimpsonX %v: is %v (%v) != should %v", xs, integral, err, last*last*last/3)
+		}
+	}
+	y := ToIterFloat64([]float64{0, 1, 4, 9})
+	if integral := y.Simpson(1); math.Abs(integral-9) > 1e-12 {
+		t.Errorf("Simpson: is %v != should 9", integral)
+	}
+	if _, err := y.TrapzX(ToIterFloat64([]float64{0, 1})); err != ErrDiffLen {
+		t.Errorf("TrapzX: different length should return ErrDiffLen not %v", err)
+	}
+}
+
+func ExampleIterableFloat64_Gradient() {
+	y := ToIterFloat64([]float64{0, 1, 4, 9, 16})
+	fmt.Println(y.Diff().List(), y.Diff(2).List(), y.Gradient().List())
+	fmt.Println(y.Trapz(1), y.Simpson(1), y.CumulativeTrapz(1).List())
+	// Output: [1 3 5 7] [2 2 2] [1 2 4 6 7]
+	// 22 21.333333333333332 [0 0.5 3 9.5 22]
+}
+
+func TestVectorFloat64(t *testing.T) {
+	a, b := ToIterFloat64(f1[:1000]), ToIterFloat64(f1[1000:2000])
+	sum := a.Add(b).List()
+	for i, v := range sum {
+		if v != f1[i]+f1[1000+i] {
+			t.Errorf("Add: element %3d is %v != should %v", i, v, f1[i]+f1[1000+i])
+			break
+		}
+	}
+	if dot := a.Dot(a); math.Abs(dot-a.Norm()*a.Norm()) > 1e-9 {
+		t.Errorf("Dot: %v differs from Norm² %v", dot, a.Norm()*a.Norm())
+	}
+	if cos := a.CosineSimilarity(a.MulScalar(3)); math.Abs(cos-1) > 1e-12 {
+		t.Errorf("CosineSimilarity: parallel vectors is %v != should 1", cos)
+	}
+
+	// Into changes the underlying slice
+	l := []float64{1, 2, 3}
+	seq := ToIterFloat64(l)
+	if seq.MulScalarInto(2).AddInto(ToIterFloat64([]float64{1, 1, 1})) != seq || l[2] != 7 {
+		t.Errorf("MulScalarInto/AddInto: underlying slice is %v != should [3 5 7]", l)
+	}
+
+	// NaN of either side propagates like numpy.minimum / numpy.maximum
+	x, y := ToIterFloat64([]float64{math.NaN(), 1, 5}), ToIterFloat64([]float64{2, math.NaN(), 3})
+	for _, c := range []struct {
+		name string
+		got  *IterableFloat64
+		last float64
+	}{
+		{"x.Minimum(y)", x.Minimum(y), 3}, {"y.Minimum(x)", y.Minimum(x), 3},
+		{"x.Maximum(y)", x.Maximum(y), 5}, {"y.Maximum(x)", y.Maximum(x), 5},
+	} {
+		if l := c.got.List(); !math.IsNaN(l[0]) || !math.IsNaN(l[1]) || l[2] != c.last {
+			t.Errorf("%v with NaN: %v != should [NaN NaN %v]", c.name, l, c.last)
+		}
+	}
+	if l := y.MinimumScalar(math.NaN()).List(); !math.IsNaN(l[0]) || !math.IsNaN(l[2]) {
+		t.Errorf("MinimumScalar(NaN): %v != should [NaN NaN NaN]", l)
+	}
+	if l := y.MaximumScalar(math.NaN()).List(); !math.IsNaN(l[0]) || !math.IsNaN(l[2]) {
+		t.Errorf("MaximumScalar(NaN): %v != should [NaN NaN NaN]", l)
+	}
+
+	defer func() {
+		if r := recover(); r != ERR_DIFFLEN {
+			t.Errorf("Add: different length should panic with ERR_DIFFLEN not %v", r)
+		}
+	}()
+	a.Add(ToIterFloat64([]float64{1}))
+}
+
+func ExampleIterableInt_Add() {
+	a, b := ToIterInt([]int{1, 2, 3, 4}), ToIterInt([]int{4, 3, 2, 1})
+	fmt.Println(a.Add(b).List(), a.Sub(b).List(), a.Mul(b).List(), a.Div(b).List())
+	fmt.Println(a.PowScalar(2).List(), a.Minimum(b).List(), a.MaximumScalar(2).List())
+	fmt.Println(a.Dot(b), a.Norm(NORML1), a.Norm(NORMINF), ToIterInt([]int{3, 4}).Norm())
+	// Output: [5 5 5 5] [-3 -1 1 3] [4 6 6 4] [0 0 1 4]
+	// [1 4 9 16] [1 2 2 1] [2 2 3 4]
+	// 20 10 4 5
+}
+
+func TestFastKernels(t *testing.T) {
+	// all lengths up to some full loops plus every tail length
+	for n := 0; n < 40; n++ {
+		x, y := ToIterFloat64(ToIterFloat64(f1[:n]).ToList()), ToIterFloat64(ToIterFloat64(f2[100:100+n]).ToList())
+		x32, y32 := make([]float32, n), make([]float32, n)
+		for i := range x32 {
+			x32[i], y32[i] = float32(f1[i]), float32(f2[100+i])
+		}
+		fx, fy := ToIterFloat32(x32), ToIterFloat32(y32)
+		if sum, fast := x.Sum(), x.SumFast(); math.Abs(sum-fast) > 1e-12 {
+			t.Errorf("SumFast len %v: is %v != should %v", n, fast, sum)
+		}
+		if dot, fast := x.Dot(y), x.DotFast(y); math.Abs(dot-fast) > 1e-12 {
+			t.Errorf("DotFast len %v: is %v != should %v", n, fast, dot)
+		}
+		if sum, fast := fx.Sum(), fx.SumFast(); math.Abs(sum-float64(fast)) > 1e-4 {
+			t.Errorf("SumFast float32 len %v: is %v != should %v", n, fast, sum)
+		}
+		if dot, fast := fx.Dot(fy), fx.DotFast(fy); math.Abs(dot-float64(fast)) > 1e-4 {
+			t.Errorf("DotFast float32 len %v: is %v != should %v", n, fast, dot)
+		}
+		want := x.MulScalar(3).Add(y).List()
+		want32 := fx.MulScalar(3).Add(fy).List()
+		y.AXPY(3, x)
+		fy.AXPY(3, fx)
+		x.Scale(2)
+		fx.Scale(2)
+		for i := 0; i < n; i++ {
+			if y.List()[i] != want[i] || fy.List()[i] != want32[i] {
+				t.Errorf("AXPY len %v: element %v is %v / %v != should %v / %v", n, i, y.List()[i], fy.List()[i], want[i], want32[i])
+			}
+			if x.List()[i] != 2*f1[i] || fx.List()[i] != 2*float32(f1[i]) {
+				t.Errorf("Scale len %v: element %v is %v / %v != should %v", n, i, x.List()[i], fx.List()[i], 2*f1[i])
+			}
+		}
+	}
+	// the kernels must not run over the end of the shorter slice, whatever the Len fields say
+	short := ToIterFloat64([]float64{1, 2})
+	short.Len = 40
+	long := ToIterFloat64(f1[:40])
+	for name, fn := range map[string]func(){
+		"DotFast":      func() { long.DotFast(short) },
+		"AXPY":         func() { short.AXPY(2, long) },
+		"AXPY shorter": func() { long.AXPY(2, short) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != ERR_DIFFLEN {
+					t.Errorf("%v: slices of different length should panic with ERR_DIFFLEN not %v", name, r)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+func TestFIRFloat64(t *testing.T) {
+	seq, taps := ToIterFloat64(f1[:1000]), ToIterFloat64([]float64{0.25, 0.5, 0.25, -0.1, 0.05})
+	conv, fir := seq.Convolve(taps).List(), seq.FIR(taps).List()
+	for i, v := range fir {
+		if math.Abs(v-conv[i]) > 1e-12 {
+			t.Errorf("FIR: element %3d is %v != should %v", i, v, conv[i])
+			break
+		}
+	}
+	if n := len(seq.Convolve(taps, CONVVALID).List()); n != 996 {
+		t.Errorf("Convolve CONVVALID: length is %v != should 996", n)
+	}
+	if n := len(taps.Convolve(seq, CONVSAME).List()); n != 1000 {
+		t.Errorf("Convolve CONVSAME: length is %v != should 1000", n)
+	}
+}
+
+func ExampleIterableFloat64_Convolve() {
+	a, v := ToIterFloat64([]float64{1, 2, 3}), ToIterFloat64([]float64{0, 1, 0.5})
+	fmt.Println(a.Convolve(v).List(), a.Convolve(v, CONVSAME).List(), a.Convolve(v, CONVVALID).List())
+	fmt.Println(a.Correlate(v).List(), a.Correlate(v, CONVFULL).List())
+	for step, y, ex := a.FIRNext(v), 0.0, false; ; {
+		y, ex = step()
+		if ex {
+			break
+		}
+		fmt.Printf("%v ", y)
+	}
+	// Output: [0 1 2.5 4 1.5] [1 2.5 4] [2.5]
+	// [3.5] [0.5 2 3.5 3 0]
+	// 0 1 2.5
+}
+
+func TestFFT(t *testing.T) {
+	// radix-2 and Bluestein lengths against the naive DFT
+	for _, n := range []int{1, 2, 7, 8, 12, 64, 100} {
+		x := make([]complex128, n)
+		for i := range x {
+			x[i] = complex(f1[i], f2[n+i])
+		}
+		seq := ToIterComplex128(x)
+		spectrum := seq.FFT().List()
+		for k := range spectrum {
+			var dft complex128
+			for j, v := range x {
+				dft += v * cmplx.Exp(complex(0, -2*math.Pi*float64(j*k)/float64(n)))
+			}
+			if cmplx.Abs(spectrum[k]-dft) > 1e-9 {
+				t.Errorf("FFT len %v: bin %v is %v != should %v", n, k, spectrum[k], dft)
+			}
+		}
+		for i, v := range seq.FFT().IFFT().List() {
+			if cmplx.Abs(v-x[i]) > 1e-12 {
+				t.Errorf("IFFT(FFT) len %v: element %v is %v != should %v", n, i, v, x[i])
+			}
+		}
+	}
+	for _, n := range []int{9, 16} {
+		seq := ToIterFloat64(f1[:n])
+		for i, v := range seq.RFFT().IRFFT(n).List() {
+			if math.Abs(v-f1[i]) > 1e-12 {
+				t.Errorf("IRFFT(RFFT) len %v: element %v is %v != should %v", n, i, v, f1[i])
+			}
+		}
+	}
+}
+
+func ExampleIterableFloat64_RFFT() {
+	seq := ToIterFloat64([]float64{1, 2, 3, 4})
+	fmt.Println(seq.RFFT().List(), seq.PowerSpectrum().List())
+	fmt.Println(RFFTFreq(4, 0.5).List(), FFTFreq(5, 1).List())
+	fmt.Printf("%.2f %.2f\n", HannWindow(5).List(), ToIterFloat64([]float64{1, 1, 1}).Windowed(WINHAMMING).List())
+	// Output: [(10+0i) (-2+2i) (-2+0i)] [25 2 1]
+	// [0 0.5 1] [0 0.2 0.4 -0.4 -0.2]
+	// [0.00 0.50 1.00 0.50 0.00] [0.08 1.00 0.08]
+}
+
+func TestInterpFloat64(t *testing.T) {
+	xs := ToIterFloat64([]float64{0, 1, 2, 3, 4})
+	ys := ToIterFloat64([]float64{0, 1, 8, 27, 64})
+	xNew := ToIterFloat64([]float64{-1, 0.25, 1.5, 2.5, 3, 5})
+	should := map[int][]float64{
+		INTERPLINEAR:  {0, 0.25, 4.5, 17.5, 27, 64},
+		INTERPNEAREST: {0, 0, 8, 27, 27, 64},
+		INTERPSTEP:    {0, 0, 1, 8, 27, 64},
+	}
+	for method, want := range should {
+		got, err := InterpFloat64(xs, ys, xNew, method)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, v := range got.List() {
+			if v != want[i] {
+				t.Errorf("Interp method %v: element %v is %v != should %v", method, i, v, want[i])
+			}
+		}
+	}
+	// NaN positions get NaN like numpy.interp, a NaN sample position is not increasing
+	nanNew := ToIterFloat64([]float64{math.NaN(), 1.5, math.NaN()})
+	for method := range should {
+		got, err := InterpFloat64(xs, ys, nanNew, method)
+		if err != nil || !math.IsNaN(got.List()[0]) || math.IsNaN(got.List()[1]) || !math.IsNaN(got.List()[2]) {
+			t.Errorf("Interp method %v with NaN positions: %v, %v", method, got, err)
+		}
+	}
+	if got, err := InterpFloat64(xs, ys, nanNew, INTERPCUBIC); err != nil || !math.IsNaN(got.List()[0]) {
+		t.Errorf("Interp cubic with NaN positions: %v, %v", got, err)
+	}
+	if _, err := InterpFloat64(ToIterFloat64([]float64{0, math.NaN(), 2}), ToIterFloat64([]float64{0, 1, 2}), nanNew); err != ErrNotSorted {
+		t.Errorf("Interp with a NaN sample position: %v != should %v", err, ErrNotSorted)
+	}
+	// the natural cubic spline reproduces straight lines and passes the samples
+	line, _ := InterpFloat64(xs, ToIterFloat64([]float64{1, 3, 5, 7, 9}), xNew, INTERPCUBIC)
+	for i, v := range line.List()[1:5] {
+		if want := 1 + 2*xNew.List()[i+1]; math.Abs(v-want) > 1e-12 {
+			t.Errorf("Interp cubic line: element %v is %v != should %v", i+1, v, want)
+		}
+	}
+	spline, _ := InterpFloat64(xs, ys, xs, INTERPCUBIC)
+	for i, v := range spline.List() {
+		if v != ys.List()[i] {
+			t.Errorf("Interp cubic at the samples: element %v is %v != should %v", i, v, ys.List()[i])
+		}
+	}
+	if _, err := InterpFloat64(xs, ToIterFloat64(ys.List()[:4]), xNew); err != ErrDiffLen {
+		t.Errorf("Interp with different lengths: err %v != should %v", err, ErrDiffLen)
+	}
+	if _, err := InterpFloat64(ToIterFloat64([]float64{0, 2, 1}), ToIterFloat64([]float64{0, 1, 2}), xNew); err != ErrNotSorted {
+		t.Errorf("Interp with unsorted xs: err %v != should %v", err, ErrNotSorted)
+	}
+}
+
+func TestLTTBFloat64(t *testing.T) {
+	ys := make([]float64, 1000)
+	for i := range ys {
+		ys[i] = math.Sin(float64(i) / 50)
+	}
+	ys[333] = 10
+	indices := ToIterFloat64(ys).LTTB(50).List()
+	if len(indices) != 50 || indices[0] != 0 || indices[49] != 999 {
+		t.Fatalf("LTTB: got %v indices from %v to %v", len(indices), indices[0], indices[len(indices)-1])
+	}
+	spike := false
+	for i, idx := range indices {
+		if i > 0 && idx <= indices[i-1] {
+			t.Errorf("LTTB: indices not increasing at %v: %v", i, indices)
+		}
+		spike = spike || idx == 333
+	}
+	if !spike {
+		t.Errorf("LTTB: dropped the spike at 333")
+	}
+	xs := make([]float64, len(ys))
+	for i := range xs {
+		xs[i] = float64(i)
+	}
+	withX, err := LTTBFloat64(ToIterFloat64(xs), ToIterFloat64(ys), 50)
+	if err != nil || fmt.Sprint(withX.List()) != fmt.Sprint(indices) {
+		t.Errorf("LTTBFloat64 with positions 0, 1 ...: %v, %v != should %v", withX.List(), err, indices)
+	}
+	if got := len(ToIterFloat64(ys[:10]).LTTB(20).List()); got != 10 {
+		t.Errorf("LTTB with n > len: %v indices != should 10", got)
+	}
+}
+
+func ExampleInterpFloat64() {
+	xs := ToIterFloat64([]float64{0, 1, 2})
+	ys := ToIterFloat64([]float64{0, 10, 0})
+	linear, _ := InterpFloat64(xs, ys, ToIterFloat64([]float64{0.5, 1.5, 3}))
+	step, _ := InterpFloat64(xs, ys, ToIterFloat64([]float64{0.5, 1.5, 3}), INTERPSTEP)
+	fmt.Println(linear.List(), step.List())
+	fmt.Println(ToIterFloat64([]float64{0, 10, 20}).Resample(5).List())
+	// Output: [5 5 0] [0 10 0]
+	// [0 5 10 15 20]
+}
+
+func TestRankFloat64(t *testing.T) {
+	seq := ToIterFloat64([]float64{30, 10, 20, 10, 30, 30})
+	should := map[int][]float64{
+		RANKAVERAGE: {5, 1.5, 3, 1.5, 5, 5},
+		RANKMIN:     {4, 1, 3, 1, 4, 4},
+		RANKMAX:     {6, 2, 3, 2, 6, 6},
+		RANKDENSE:   {3, 1, 2, 1, 3, 3},
+		RANKORDINAL: {4, 1, 3, 2, 5, 6},
+	}
+	for method, want := range should {
+		if got := seq.Rank(method).List(); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("Rank method %v: %v != should %v", method, got, want)
+		}
+	}
+	if got := fmt.Sprint(seq.List()); got != "[30 10 20 10 30 30]" {
+		t.Errorf("Rank changed the underlying slice: %v", got)
+	}
+	if got := seq.RankInto(RANKDENSE).List(); fmt.Sprint(got) != fmt.Sprint(should[RANKDENSE]) {
+		t.Errorf("RankInto: %v != should %v", got, should[RANKDENSE])
+	}
+}
+
+func TestOrderStatisticsNaNFloat64(t *testing.T) {
+	// NaN sorts last wherever it is: the order statistics are NaN, Rank and QCut leave it out
+	data := []float64{4, 1, 3, 2}
+	for _, at := range []int{0, 2, len(data)} {
+		withNaN := append(append(append([]float64{}, data[:at]...), math.NaN()), data[at:]...)
+		seq := ToIterFloat64(withNaN)
+		d := seq.Describe()
+		if !math.IsNaN(seq.Median()) || !math.IsNaN(seq.Quantile(0, QLOWER)) || !math.IsNaN(d.Min) || !math.IsNaN(d.Q25) || !math.IsNaN(d.Max) {
+			t.Errorf("NaN at %v: Median %v, Quantile(0) %v, Describe %+v != should NaN", at, seq.Median(), seq.Quantile(0, QLOWER), d)
+		}
+		if got := ToIterFloat64(withNaN).DropNaN().Median(); got != 2.5 {
+			t.Errorf("NaN at %v: Median after DropNaN %v != should 2.5", at, got)
+		}
+		// the indices of the data behind the NaN are shifted by one
+		shift := func(i int) int {
+			if i >= at {
+				return i + 1
+			}
+			return i
+		}
+		wantRank, wantBins := make([]float64, 0, 5), make([]int, 0, 5)
+		for i, v := range withNaN {
+			if i == at {
+				wantRank, wantBins = append(wantRank, math.NaN()), append(wantBins, -1)
+				continue
+			}
+			wantRank, wantBins = append(wantRank, v), append(wantBins, int(v-1)/2)
+		}
+		if got := seq.Rank().List(); fmt.Sprint(got) != fmt.Sprint(wantRank) {
+			t.Errorf("NaN at %v: Rank %v != should %v", at, got, wantRank)
+		}
+		wantIdx := []int{shift(1), shift(3), shift(2), shift(0), at}
+		if got := seq.Argsort().List(); fmt.Sprint(got) != fmt.Sprint(wantIdx) {
+			t.Errorf("NaN at %v: Argsort %v != should %v", at, got, wantIdx)
+		}
+		bins, edges := seq.QCut(2)
+		if fmt.Sprint(bins.List()) != fmt.Sprint(wantBins) || fmt.Sprint(edges.List()) != "[1 2.5 4]" {
+			t.Errorf("NaN at %v: QCut bins %v, edges %v != should %v, [1 2.5 4]", at, bins.List(), edges.List(), wantBins)
+		}
+	}
+	if got := ToIterFloat64([]float64{math.NaN(), 2, math.NaN(), 1}).Argsort().List(); fmt.Sprint(got) != "[3 1 0 2]" {
+		t.Errorf("Argsort with NaN: %v != should [3 1 0 2]", got)
+	}
+	if rho, err := SpearmanFloat64(ToIterFloat64([]float64{1, math.NaN(), 2}), ToIterFloat64([]float64{1, 2, 3})); err != nil || !math.IsNaN(rho) {
+		t.Errorf("Spearman with NaN: %v, %v != should NaN", rho, err)
+	}
+}
+
+func TestFeaturesFloat64(t *testing.T) {
+	data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	z := ToIterFloat64(data).Standardize()
+	if m, sd := z.Mean(), z.StdDev(0); math.Abs(m) > 1e-15 || math.Abs(sd-1) > 1e-15 {
+		t.Errorf("Standardize: mean %v, stddev %v != should 0, 1", m, sd)
+	}
+	if got := ToIterFloat64([]float64{3, 3}).Normalize(-1, 1).List(); got[0] != -1 || got[1] != -1 {
+		t.Errorf("Normalize constant: %v != should [-1 -1]", got)
+	}
+	if got := ToIterFloat64([]float64{3, 3}).Standardize().List(); got[0] != 0 || got[1] != 0 {
+		t.Errorf("Standardize constant: %v != should [0 0]", got)
+	}
+	seq := ToIterFloat64(data)
+	if seq.ClipInto(3, 6) != seq || fmt.Sprint(data) != "[3 4 4 4 5 5 6 6]" {
+		t.Errorf("ClipInto: %v != should [3 4 4 4 5 5 6 6]", data)
+	}
+	unsorted := []float64{3, 1, 2, 1}
+	indices := ToIterFloat64(unsorted).ArgsortInto().List()
+	if fmt.Sprint(indices) != "[1 3 2 0]" || fmt.Sprint(unsorted) != "[1 1 2 3]" {
+		t.Errorf("ArgsortInto: indices %v, elements %v != should [1 3 2 0], [1 1 2 3]", indices, unsorted)
+	}
+}
+
+func ExampleIterableFloat64_Normalize() {
+	seq := ToIterFloat64([]float64{1, 3, 5, -3})
+	fmt.Println(seq.Normalize().List(), seq.Normalize(-1, 1).List())
+	fmt.Println(seq.Clip(0, 4).List(), seq.Rank().List(), seq.Argsort().List())
+	fmt.Printf("%.4f\n", seq.StandardizeInto().List())
+	// Output: [0.5 0.75 1 0] [0 0.5 1 -1]
+	// [1 3 4 0] [2 3 4 1] [3 0 1 2]
+	// [-0.1690 0.5071 1.1832 -1.5213]
+}
+
+func TestNaNFloat64(t *testing.T) {
+	nan := math.NaN()
+	seq := ToIterFloat64([]float64{nan, 1, nan, 4, 2, nan})
+	if got := seq.NanSum(); got != 7 {
+		t.Errorf("NanSum: %v != should 7", got)
+	}
+	if mean, min, max, median := seq.NanMean(), seq.NanMin(), seq.NanMax(), seq.NanMedian(); mean != 7.0/3 || min != 1 || max != 4 || median != 2 {
+		t.Errorf("NanMean, NanMin, NanMax, NanMedian: %v %v %v %v != should %v 1 4 2", mean, min, max, median, 7.0/3)
+	}
+	if got, want := seq.NanVariance(), ToIterFloat64([]float64{1, 4, 2}).Variance(); got != want {
+		t.Errorf("NanVariance: %v != should %v", got, want)
+	}
+	if got := seq.CountNaN(); got != 3 {
+		t.Errorf("CountNaN: %v != should 3", got)
+	}
+	allNaN := ToIterFloat64([]float64{nan, nan})
+	if !math.IsNaN(allNaN.NanMean()) || !math.IsNaN(allNaN.NanMin()) || !math.IsNaN(allNaN.NanMedian()) || allNaN.NanSum() != 0 {
+		t.Errorf("Nan aggregates over NaN only: %v %v %v %v", allNaN.NanMean(), allNaN.NanMin(), allNaN.NanMedian(), allNaN.NanSum())
+	}
+	should := map[int]string{
+		FILLFORWARD:  "[NaN 1 1 4 2 2]",
+		FILLBACKWARD: "[1 1 4 4 2 NaN]",
+		FILLLINEAR:   "[NaN 1 2.5 4 2 NaN]",
+	}
+	for method, want := range should {
+		if got := fmt.Sprint(seq.FillNaN(method).List()); got != want {
+			t.Errorf("FillNaN method %v: %v != should %v", method, got, want)
+		}
+	}
+	if seq.FillNaNInto(FILLCONST, 0) != seq || fmt.Sprint(seq.List()) != "[0 1 0 4 2 0]" {
+		t.Errorf("FillNaNInto FILLCONST: %v != should [0 1 0 4 2 0]", seq.List())
+	}
+}
+
+func TestApproxFloat64(t *testing.T) {
+	nan, a, b := math.NaN(), 0.1, 0.2
+	seq := ToIterFloat64([]float64{a + b, 1e9, nan})
+	if seq.Any(0.3) || !seq.AnyApprox(0.3) || seq.Index() != 0 {
+		t.Errorf("AnyApprox(0.3): Any %v, AnyApprox %v at index %v", seq.Any(0.3), seq.AnyApprox(0.3), seq.Index())
+	}
+	if !seq.AnyApprox(nan) || seq.Index() != 2 {
+		t.Errorf("AnyApprox(NaN) did not find NaN at index 2")
+	}
+	if !seq.ApproxEqual(ToIterFloat64([]float64{0.3, 1e9 + 1, nan})) {
+		t.Errorf("ApproxEqual with default tolerances: false != should true")
+	}
+	if seq.ApproxEqual(ToIterFloat64([]float64{0.3, 1e9 + 1, nan}), 1e-8, 0) {
+		t.Errorf("ApproxEqual with rtol 0: true != should false")
+	}
+	ones := ToIterFloat64([]float64{1, 1 + 1e-12, 1.1})
+	if ones.AllApprox(1) || ones.Index() != 2 || !ones.AllApprox(1, 0.2) {
+		t.Errorf("AllApprox(1): index %v != should 2", ones.Index())
+	}
+	// atol + rtol * max(|a|, |b|) in both orders: numpy.isclose (0.5 + 0.125 * |b| = 0.875 for b = 3) and
+	// math.isclose (max(0.5, 0.125 * 4) = 0.5) would both reject the distance 1
+	four, three := ToIterFloat64([]float64{4}), ToIterFloat64([]float64{3})
+	if !four.ApproxEqual(three, 0.5, 0.125) || !three.ApproxEqual(four, 0.5, 0.125) {
+		t.Errorf("ApproxEqual(atol 0.5, rtol 0.125) of 4 and 3: %v, %v != should true, true",
+			four.ApproxEqual(three, 0.5, 0.125), three.ApproxEqual(four, 0.5, 0.125))
+	}
+	if four.ApproxEqual(three, 0.5, 0.12) || three.ApproxEqual(four, 0.5, 0.12) {
+		t.Errorf("ApproxEqual(atol 0.5, rtol 0.12) of 4 and 3: true != should false")
+	}
+}
+
+func ExampleIterableFloat64_FillNaN() {
+	seq := ToIterFloat64([]float64{1, math.NaN(), math.NaN(), 4})
+	fmt.Println(seq.CountNaN(), seq.NanMean(), seq.DropNaN().List())
+	fmt.Println(seq.FillNaN(FILLLINEAR).List(), seq.FillNaN(FILLCONST, -1).List())
+	// Output: 2 2.5 [1 4]
+	// [1 2 3 4] [1 -1 -1 4]
+}
+
+func TestHistogramFloat64(t *testing.T) {
+	seq := ToIterFloat64([]float64{1, 2, 2, 3, 3, 3, 4, 4, 4, 4})
+	counts, edges := seq.Histogram(3)
+	if fmt.Sprint(counts.List()) != "[1 2 7]" || fmt.Sprint(edges.List()) != "[1 2 3 4]" {
+		t.Errorf("Histogram(3): counts %v, edges %v != should [1 2 7], [1 2 3 4]", counts.List(), edges.List())
+	}
+	density := HistDensity(counts, edges)
+	integral := 0.0
+	for i, d := range density.List() {
+		integral += d * (edges.List()[i+1] - edges.List()[i])
+	}
+	if math.Abs(integral-1) > 1e-15 {
+		t.Errorf("HistDensity: integral %v != should 1", integral)
+	}
+	if got := seq.HistogramEdges(ToIterFloat64([]float64{1.5, 3, 3.5})).List(); fmt.Sprint(got) != "[2 3]" {
+		t.Errorf("HistogramEdges: %v != should [2 3]", got)
+	}
+	if got, _ := ToIterInt([]int{7, 7}).Histogram(2); fmt.Sprint(got.List()) != "[0 2]" {
+		t.Errorf("Histogram of equal elements: %v != should [0 2]", got.List())
+	}
+	if got, e := ToIterFloat64([]float64{math.NaN(), 1, 2, math.NaN(), 4}).Histogram(3); fmt.Sprint(got.List()) != "[1 1 1]" || fmt.Sprint(e.List()) != "[1 2 3 4]" {
+		t.Errorf("Histogram with NaN: counts %v, edges %v != should [1 1 1], [1 2 3 4]", got.List(), e.List())
+	}
+	edges = ToIterFloat64([]float64{2, 3})
+	if got := ToIterInt8([]int8{1, 2, 3, 4}).Digitize(edges).List(); fmt.Sprint(got) != "[0 1 2 2]" {
+		t.Errorf("Digitize: %v != should [0 1 2 2]", got)
+	}
+	if got := ToIterInt8([]int8{1, 2, 3, 4}).Digitize(edges, true).List(); fmt.Sprint(got) != "[0 0 1 2]" {
+		t.Errorf("Digitize right: %v != should [0 0 1 2]", got)
+	}
+	bins, qedges := ToIterFloat64([]float64{8, 1, 5, 3, 2, 7, 4, 6}).QCut(4)
+	if fmt.Sprint(bins.List()) != "[3 0 2 1 0 3 1 2]" || fmt.Sprint(qedges.List()) != "[1 2.75 4.5 6.25 8]" {
+		t.Errorf("QCut(4): bins %v, edges %v", bins.List(), qedges.List())
+	}
+}
+
+func ExampleIterableFloat64_Histogram() {
+	seq := ToIterFloat64([]float64{0.5, 1.5, 1.5, 2.5, 3.5, 4})
+	counts, edges := seq.Histogram(4)
+	fmt.Println(counts.List(), edges.List(), HistDensity(counts, edges).List())
+	fmt.Println(seq.Digitize(ToIterFloat64([]float64{1, 2, 3})).List())
+	// Output: [1 2 1 2] [0.5 1.375 2.25 3.125 4] [0.19047619047619047 0.38095238095238093 0.19047619047619047 0.38095238095238093]
+	// [0 1 1 2 3 3]
+}
+
+func TestOutliersFloat64(t *testing.T) {
+	data := []float64{10, 11, 9, 10, 12, 10, 11, 9, 10, 50, -20}
+	seq := ToIterFloat64(data)
+	for _, method := range []int{OUTMAD, OUTIQR} {
+		if got := seq.Outliers(method).List(); fmt.Sprint(got) != "[9 10]" {
+			t.Errorf("Outliers method %v: %v != should [9 10]", method, got)
+		}
+	}
+	// the outliers inflate the stddev so the z-score needs a lower threshold
+	if got := seq.Outliers(OUTZSCORE).List(); fmt.Sprint(got) != "[]" {
+		t.Errorf("Outliers z-score: %v != should []", got)
+	}
+	if got := seq.Outliers(OUTZSCORE, 2).List(); fmt.Sprint(got) != "[9 10]" {
+		t.Errorf("Outliers z-score 2: %v != should [9 10]", got)
+	}
+	if got := seq.DropOutliers(OUTIQR).List(); fmt.Sprint(got) != "[10 11 9 10 12 10 11 9 10]" {
+		t.Errorf("DropOutliers: %v", got)
+	}
+	if got := seq.Winsorize(OUTMAD).List(); fmt.Sprint(got) != "[10 11 9 10 12 10 11 9 10 12 9]" {
+		t.Errorf("Winsorize: %v", got)
+	}
+	if fmt.Sprint(seq.List()) != fmt.Sprint(data) {
+		t.Errorf("Outliers changed the underlying slice: %v", seq.List())
+	}
+	if got := ToIterInt16([]int16{3, 4, 3, 5, 4, 3, 100}).Outliers(OUTIQR).List(); fmt.Sprint(got) != "[6]" {
+		t.Errorf("Outliers IterableInt16: %v != should [6]", got)
+	}
+}
+
+func ExampleIterableFloat64_Outliers() {
+	seq := ToIterFloat64([]float64{1, 2, 3, 2, 1, 2, 30})
+	fmt.Println(seq.Outliers(OUTIQR).List(), seq.DropOutliers(OUTIQR).List(), seq.Winsorize(OUTIQR).List())
+	// Output: [6] [1 2 3 2 1 2] [1 2 3 2 1 2 3]
+}
+
+func TestReturnsFloat64(t *testing.T) {
+	prices := ToIterFloat64([]float64{100, 110, 99, 120, 90, 95, 130})
+	pct := prices.PctChange()
+	if pct.Len != 6 || math.Abs(pct.List()[1]+0.1) > 1e-15 {
+		t.Errorf("PctChange: %v", pct.List())
+	}
+	// compounding the returns gives back the prices
+	growth := pct.Map(func(r float64) float64 { return 1 + r }).CumProd().List()
+	for i, g := range growth {
+		if want := prices.List()[i+1] / 100; math.Abs(g-want) > 1e-13 {
+			t.Errorf("CumProd of 1 + PctChange: element %v is %v != should %v", i, g, want)
+		}
+	}
+	if got := ToIterFloat64(prices.LogReturns().List()).Sum(); math.Abs(got-math.Log(1.3)) > 1e-15 {
+		t.Errorf("sum of LogReturns: %v != should %v", got, math.Log(1.3))
+	}
+	if got := prices.PctChange(3).List(); fmt.Sprint(got) != "[0.2 -0.18181818181818182 -0.04040404040404041 0.08333333333333333]" {
+		t.Errorf("PctChange(3): %v", got)
+	}
+	if got := prices.CumMax().List(); fmt.Sprint(got) != "[100 110 110 120 120 120 130]" {
+		t.Errorf("CumMax: %v", got)
+	}
+	dd, start, end := prices.MaxDrawdown()
+	if dd != 0.25 || start != 3 || end != 4 {
+		t.Errorf("MaxDrawdown: %v from %v to %v != should 0.25 from 3 to 4", dd, start, end)
+	}
+	if got := prices.Drawdown().Min(); got != -0.25 {
+		t.Errorf("Drawdown min: %v != should -0.25", got)
+	}
+	step := prices.DrawdownNext()
+	for i := 0; i < prices.Len; i++ {
+		step()
+	}
+	if _, exhausted := step(); !exhausted {
+		t.Errorf("DrawdownNext not exhausted after Len steps")
+	}
+	if dd, start, end := ToIterFloat64([]float64{1, 2, 3}).MaxDrawdown(); dd != 0 || start != 0 || end != 0 {
+		t.Errorf("MaxDrawdown of a rising series: %v %v %v != should 0 0 0", dd, start, end)
+	}
+	returns := ToIterFloat64([]float64{0.01, -0.02, 0.03, 0.01})
+	if got, want := returns.SharpeRatio(0, 4), 0.0075/returns.StdDev()*2; math.Abs(got-want) > 1e-15 {
+		t.Errorf("SharpeRatio: %v != should %v", got, want)
+	}
+	if got, want := returns.SortinoRatio(0), 0.0075/0.01; math.Abs(got-want) > 1e-15 {
+		t.Errorf("SortinoRatio: %v != should %v", got, want)
+	}
+}
+
+func ExampleIterableFloat64_MaxDrawdown() {
+	prices := ToIterFloat64([]float64{10, 12, 9, 11, 6, 14})
+	fmt.Println(prices.MaxDrawdown())
+	fmt.Println(prices.CumMax().List(), prices.Drawdown().List())
+	// Output: 0.5 1 4
+	// [10 12 12 12 12 14] [0 0 -0.25 -0.08333333333333337 -0.5 0]
+}
+
+func TestParallelInt(t *testing.T) {
+	data := make([]int, 1001)
+	for i := range data {
+		data[i] = i
+	}
+	seq := ToIterInt(data)
+	square := func(x int) int { return x * x }
+	even := func(x int) bool { return x&1 == 0 }
+	add := func(x, y int) int { return x + y }
+	for _, workers := range []int{1, 2, 3, 7, 2000} {
+		if got, want := seq.ParallelMap(square, workers).List(), seq.Map(square).List(); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("ParallelMap with %v workers differs from Map", workers)
+		}
+		if got, want := seq.ParallelFilter(even, workers).List(), seq.Filter(even).List(); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("ParallelFilter with %v workers differs from Filter", workers)
+		}
+		if got := seq.ParallelReduce(add, workers); got != 500500 {
+			t.Errorf("ParallelReduce with %v workers: %v != should 500500", workers, got)
+		}
+	}
+	if seq.ParallelMapInto(func(x int) int { return -x }) != seq || data[1000] != -1000 {
+		t.Errorf("ParallelMapInto did not change the underlying slice: %v", data[1000])
+	}
+	// concatenation is associative but not commutative, so the parts must be merged in order
+	words := ToIterString(strings.Split("a b c d e f g h i j", " "))
+	if got := words.ParallelReduce(func(x, y string) string { return x + y }, 4); got != "abcdefghij" {
+		t.Errorf("ParallelReduce of strings: %v != should abcdefghij", got)
+	}
+	ifs := ToIterIf([]interface{}{1, "b", 3.0, 4})
+	if got := ifs.ParallelFilter(func(v interface{}) bool { _, ok := v.(int); return ok }, 3).List(); fmt.Sprint(got) != "[1 4]" {
+		t.Errorf("IterableIf ParallelFilter: %v != should [1 4]", got)
+	}
+}
+
+func ExampleIterableInt_ParallelMap() {
+	seq := ToIterInt([]int{1, 2, 3, 4, 5, 6, 7})
+	squares := seq.ParallelMap(func(x int) int { return x * x }, 3)
+	fmt.Println(squares.List(), squares.ParallelFilter(func(x int) bool { return x > 10 }, 3).List())
+	fmt.Println(squares.ParallelReduce(func(x, y int) int { return x + y }, 3))
+	// Output: [1 4 9 16 25 36 49] [16 25 36 49]
+	// 140
+}
+
+func TestParallelAccumulateSortInt64(t *testing.T) {
+	n := 3*PARALLELTHRESHOLD + 17
+	data := make([]int64, n)
+	for i := range data {
+		data[i] = int64(prng.Intn(1000))*int64(n) + int64(i)
+	}
+	seq := ToIterInt64(data)
+	add := func(x, y int64) int64 { return x + y }
+	want := seq.Accumulate(add).List()
+	for _, workers := range []int{1, 2, 3, 8} {
+		if got := seq.ParallelAccumulate(add, workers).List(); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("ParallelAccumulate with %v workers differs from Accumulate", workers)
+		}
+	}
+	// sorting by the key x / n must keep the original order (x % n) of equal keys
+	byKey := func(x, y int64) bool { return x/int64(n) < y/int64(n) }
+	for _, workers := range []int{1, 2, 3, 8} {
+		sorted := seq.ParallelSort(byKey, workers).List()
+		for i := 1; i < len(sorted); i++ {
+			if byKey(sorted[i], sorted[i-1]) || !byKey(sorted[i-1], sorted[i]) && sorted[i] < sorted[i-1] {
+				t.Fatalf("ParallelSort with %v workers: not stable sorted at %v: %v %v", workers, i, sorted[i-1], sorted[i])
+			}
+		}
+	}
+	if seq.ParallelSortInto(func(x, y int64) bool { return x > y }) != seq {
+		t.Errorf("ParallelSortInto does not return the iterable")
+	}
+	for i := 1; i < len(data); i++ {
+		if data[i] > data[i-1] {
+			t.Fatalf("ParallelSortInto descending: %v > %v at %v", data[i], data[i-1], i)
+		}
+	}
+	words := ToIterString(strings.Split("d a c b", " "))
+	if got := words.ParallelSort(func(x, y string) bool { return x < y }).List(); fmt.Sprint(got) != "[a b c d]" {
+		t.Errorf("ParallelSort of strings: %v", got)
+	}
+}
+
+func ExampleIterableInt_Accumulate() {
+	seq := ToIterInt([]int{3, 1, 4, 1, 5})
+	fmt.Println(seq.Accumulate(func(x, y int) int { return x + y }).List())
+	fmt.Println(seq.ParallelAccumulate(func(x, y int) int {
+		if x > y {
+			return x
+		}
+		return y
+	}).List())
+	fmt.Println(seq.ParallelSort(func(x, y int) bool { return x < y }).List())
+	// Output: [3 4 8 9 14]
+	// [3 3 4 4 5]
+	// [1 1 3 4 5]
+}
+
+// run with go test -race to check the concurrent consumption
+func TestSynchronizedInt(t *testing.T) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = i
+	}
+	synced := ToIterInt(data).Synchronized()
+	next := synced.MapNext(func(x int) int { return x })
+	seen := make([]int32, len(data))
+	wg := new(sync.WaitGroup)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v, exhausted := next()
+				if exhausted {
+					return
+				}
+				atomic.AddInt32(&seen[v], 1)
+				synced.Index()
+			}
+		}()
+	}
+	wg.Wait()
+	for i, n := range seen {
+		if n != 1 {
+			t.Fatalf("Synchronized MapNext: element %v handed out %v times", i, n)
+		}
+	}
+	// Next and Reset of several goroutines must not race
+	synced.Reset()
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				synced.Next()
+				synced.This()
+			}
+		}()
+	}
+	wg.Wait()
+	if got := synced.Index(); got != 3999 {
+		t.Errorf("Synchronized Next: index %v != should 3999", got)
+	}
+	// MapInto and ToList of two goroutines must not race, ToList sees all or none of a MapInto
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			synced.MapInto(func(x int) int { return x + 1 })
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			l := synced.ToList()
+			if l[len(l)-1]-l[0] != len(data)-1 {
+				t.Errorf("Synchronized ToList: copied during MapInto %v .. %v", l[0], l[len(l)-1])
+			}
+		}
+	}()
+	wg.Wait()
+	if got := synced.ToList()[0]; got != 10 {
+		t.Errorf("Synchronized MapInto: first element %v != should 10", got)
+	}
+	// Destroy empties the wrapper (not only the original behind it)
+	orig := ToIterInt([]int{1, 2, 3})
+	synced = orig.Synchronized()
+	synced.Destroy()
+	if synced.Len != 0 || len(synced.List()) != 0 || len(synced.ToList()) != 0 || orig.Len != 0 {
+		t.Errorf("Synchronized Destroy: Len %v, List %v, original Len %v != should 0, [], 0", synced.Len, synced.List(), orig.Len)
+	}
+}
+
+func TestAtomicNextString(t *testing.T) {
+	data := make([]string, 5000)
+	for i := range data {
+		data[i] = fmt.Sprint(i)
+	}
+	seq := ToIterString(data)
+	next := seq.AtomicNext()
+	counts := make([]map[string]int, 8)
+	wg := new(sync.WaitGroup)
+	for g := range counts {
+		counts[g] = map[string]int{}
+		wg.Add(1)
+		go func(count map[string]int) {
+			defer wg.Done()
+			for v, exhausted := next(); !exhausted; v, exhausted = next() {
+				count[v]++
+			}
+		}(counts[g])
+	}
+	wg.Wait()
+	total := map[string]int{}
+	for _, count := range counts {
+		for v, n := range count {
+			total[v] += n
+		}
+	}
+	if len(total) != len(data) {
+		t.Fatalf("AtomicNext: %v distinct elements != should %v", len(total), len(data))
+	}
+	for v, n := range total {
+		if n != 1 {
+			t.Fatalf("AtomicNext: element %v handed out %v times", v, n)
+		}
+	}
+	if seq.Index() != -1 {
+		t.Errorf("AtomicNext changed the index to %v", seq.Index())
+	}
+	if v, exhausted := ToIterIf([]interface{}{}).AtomicNext()(); v != nil || !exhausted {
+		t.Errorf("AtomicNext of an empty IterableIf: %v, %v != should nil, true", v, exhausted)
+	}
+}
+
+func TestCursorFloat64(t *testing.T) {
+	seq := ToIterFloat64([]float64{1, 2, 3})
+	c1, c2 := seq.Cursor(), seq.Cursor()
+	c1.Next()
+	c1.Next()
+	seq.Any(3)
+	if v, exhausted := c2.Next(); v != 1 || exhausted {
+		t.Errorf("second cursor Next: %v, %v != should 1, false", v, exhausted)
+	}
+	if v, _ := c1.Peek(); v != 3 || c1.Index() != 1 {
+		t.Errorf("first cursor Peek: %v at index %v != should 3 at 1", v, c1.Index())
+	}
+	if seq.Index() != 2 {
+		t.Errorf("iterable index moved by the cursors: %v != should 2", seq.Index())
+	}
+	c1.Next()
+	if v, exhausted := c1.Next(); v != MINFLOAT64 || !exhausted || c1.Index() != 3 {
+		t.Errorf("cursor Next behind the end: %v, %v at %v", v, exhausted, c1.Index())
+	}
+	if v, exhausted := c1.Back(); v != 3 || exhausted {
+		t.Errorf("cursor Back from the end: %v, %v != should 3, false", v, exhausted)
+	}
+	if idx, outside := c1.SetIndex(-5); idx != -1 || !outside {
+		t.Errorf("cursor SetIndex(-5): %v, %v != should -1, true", idx, outside)
+	}
+	if idx, outside := c1.SetIndex(1); idx != 1 || outside {
+		t.Errorf("cursor SetIndex(1): %v, %v != should 1, false", idx, outside)
+	}
+	if _, exhausted := c1.Back(); exhausted {
+		t.Errorf("cursor Back to the first element exhausted")
+	}
+	if _, exhausted := c1.Back(); !exhausted || c1.Index() != -1 {
+		t.Errorf("cursor Back before the first element not exhausted at %v", c1.Index())
+	}
+}
+
+func ExampleIterableFloat64_Cursor() {
+	seq := ToIterFloat64([]float64{1, 2, 3})
+	slow, fast := seq.Cursor(), seq.Cursor()
+	fast.Next()
+	for {
+		v, exhausted := fast.Next()
+		if exhausted {
+			break
+		}
+		prev, _ := slow.Next()
+		fmt.Println(prev, v)
+	}
+	// Output: 1 2
+	// 2 3
+}
+
+func TestTeeNextInt(t *testing.T) {
+	calls := 0
+	gen := func() (int, bool) {
+		if calls == 100 {
+			return MININT, true
+		}
+		calls++
+		return calls, false
+	}
+	copies := TeeNextInt(gen, 3)
+	// the first copy runs ahead, the others follow in lockstep
+	for i := 1; i <= 10; i++ {
+		if v, _ := copies[0](); v != i {
+			t.Fatalf("TeeNextInt first copy: %v != should %v", v, i)
+		}
+	}
+	sums := make([]int, 3)
+	for {
+		exhausted := true
+		for c, next := range copies {
+			if v, ex := next(); !ex {
+				sums[c] += v
+				exhausted = false
+			}
+		}
+		if exhausted {
+			break
+		}
+	}
+	if sums[0] != 5050-55 || sums[1] != 5050 || sums[2] != 5050 || calls != 100 {
+		t.Errorf("TeeNextInt: sums %v, %v calls of the source != should [4995 5050 5050], 100", sums, calls)
+	}
+	// only the span between the slowest and the fastest copy is buffered
+	tee := &teeInt{next: ToIterInt(make([]int, 1000)).Cursor().Next, pos: make([]int, 2)}
+	for i := 0; i < 1000; i++ {
+		tee.get(0)
+		if i%2 == 0 {
+			tee.get(1)
+		}
+		if len(tee.buf) > i/2+1 {
+			t.Fatalf("TeeNextInt buffers %v elements at step %v", len(tee.buf), i)
+		}
+	}
+	tee.get(0)
+	for i := 0; i < 500; i++ {
+		tee.get(1)
+	}
+	if len(tee.buf) != 0 {
+		t.Errorf("TeeNextInt buffers %v elements after all copies got them", len(tee.buf))
+	}
+}
+
+func ExampleIterableString_TeeCopies() {
+	seq := ToIterString([]string{"a", "b", "c"})
+	copies := seq.TeeCopies(2)
+	first, _ := copies[0]()
+	second, _ := copies[0]()
+	again, _ := copies[1]()
+	fmt.Println(first, second, again)
+	fmt.Println(seq.Tee(3)[1].List())
+	// Output: a b a
+	// [b]
+}
+
+func TestChanPipelineInt(t *testing.T) {
+	ctx := context.Background()
+	seq := ToIterInt([]int{1, 2, 3, 4, 5, 6})
+	squares := MapStageInt(ctx, seq.ToChan(ctx, 2), func(x int) int { return x * x }, 2)
+	even := FilterStageInt(ctx, squares, func(x int) bool { return x&1 == 0 }, 0)
+	stream := FromChanInt(ctx, even)
+	if v := stream.Next(); v != 4 || stream.This() != 4 || stream.Index() != 0 {
+		t.Errorf("StreamInt Next: %v at %v != should 4 at 0", v, stream.Index())
+	}
+	if got := CollectInt(stream.MapNext(func(x int) int { return x })).List(); fmt.Sprint(got) != "[16 36]" {
+		t.Errorf("ToChan - MapStage - FilterStage: %v != should [16 36]", got)
+	}
+	if v := stream.Next(); v != 36 || stream.Index() != 3 {
+		t.Errorf("StreamInt Next behind the last element: %v at %v != should 36 at 3", v, stream.Index())
+	}
+	odd := PipeInt(ctx, seq.FilterNext(func(x int) bool { return x&1 == 1 }), 1)
+	if got := CollectInt(FromChanInt(ctx, odd).FilterNext(func(x int) bool { return x > 1 })).List(); fmt.Sprint(got) != "[3 5]" {
+		t.Errorf("PipeInt of FilterNext: %v != should [3 5]", got)
+	}
+	if seq.Index() != 6 {
+		t.Errorf("ToChan changed the index: %v != should 6 (FilterNext)", seq.Index())
+	}
+}
+
+func TestChanCancelInt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	data := make([]int, 1000)
+	out := MapStageInt(ctx, ToIterInt(data).ToChan(ctx), func(x int) int { return x + 1 }, 0)
+	if v := <-out; v != 1 {
+		t.Fatalf("MapStage: %v != should 1", v)
+	}
+	cancel()
+	// the stages stop sending and close their channels
+	done := make(chan int)
+	go func() {
+		n := 0
+		for range out {
+			n++
+		}
+		done <- n
+	}()
+	select {
+	case n := <-done:
+		// select picks randomly between a ready send and the done context, so a few may still pass
+		if n > 100 {
+			t.Errorf("MapStage sent %v elements after the cancel", n)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("MapStage did not close its channel after the cancel")
+	}
+	if stream := FromChanInt(ctx, make(chan int)); stream.Next() != MININT || stream.Index() != 0 {
+		t.Errorf("FromChanInt with a done context not exhausted")
+	}
+}
+
+func ExampleMapStageFloat64() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	in := ToIterFloat64([]float64{1, 2, 3}).ToChan(ctx)
+	halves := MapStageFloat64(ctx, in, func(x float64) float64 { return x / 2 }, 1)
+	for v := range halves {
+		fmt.Println(v)
+	}
+	// Output: 0.5
+	// 1
+	// 1.5
+}
+
+func TestCtxInt(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+	seq := ToIterInt(data)
+	// cancelCtx returns a context and a mapFn canceling it at the element 50
+	cancelCtx := func() (context.Context, func(int) int) {
+		ctx, cancel := context.WithCancel(context.Background())
+		return ctx, func(x int) int {
+			if x == 50 {
+				cancel()
+			}
+			return x
+		}
+	}
+	ctx, fn := cancelCtx()
+	mapped, pos, err := seq.MapCtx(ctx, fn, 10)
+	if pos != 60 || mapped.Len != 60 || err != context.Canceled {
+		t.Errorf("MapCtx: pos %v, len %v, err %v != should 60, 60, %v", pos, mapped.Len, err, context.Canceled)
+	}
+	ctx, fn = cancelCtx()
+	filtered, pos, err := seq.FilterCtx(ctx, func(x int) bool { return fn(x)%2 == 0 }, 7)
+	if pos != 56 || filtered.Len != 28 || err != context.Canceled {
+		t.Errorf("FilterCtx: pos %v, len %v, err %v != should 56, 28, %v", pos, filtered.Len, err, context.Canceled)
+	}
+	ctx, fn = cancelCtx()
+	sum, pos, err := seq.ReduceCtx(ctx, func(x, y int) int { return x + fn(y) }, 10)
+	if sum != 59*60/2 || pos != 60 || err != context.Canceled {
+		t.Errorf("ReduceCtx: sum %v, pos %v, err %v != should 1770, 60, %v", sum, pos, err, context.Canceled)
+	}
+	done, cancel := context.WithCancel(context.Background())
+	cancel()
+	sum, pos, err = ToIterInt([]int{5, 6, 7}).ReduceCtx(done, func(x, y int) int { return x + y })
+	if sum != 5 || pos != 1 || err != context.Canceled {
+		t.Errorf("ReduceCtx canceled: sum %v, pos %v, err %v != should 5, 1, %v", sum, pos, err, context.Canceled)
+	}
+	ctx, fn = cancelCtx()
+	mapped, pos, err = seq.ParallelMapCtx(ctx, fn, 10, 1)
+	if pos != 60 || mapped.Len != 60 || err != context.Canceled {
+		t.Errorf("ParallelMapCtx with 1 worker: pos %v, len %v, err %v != should 60, 60, %v", pos, mapped.Len, err, context.Canceled)
+	}
+	ctx, fn = cancelCtx()
+	mapped, pos, err = seq.ParallelMapCtx(ctx, fn, 10, 4)
+	if pos < 60 || pos > 250 || mapped.List()[pos-1] != pos-1 || err != context.Canceled {
+		t.Errorf("ParallelMapCtx with 4 workers: pos %v, err %v", pos, err)
+	}
+	if _, pos, err := seq.MapCtx(context.Background(), func(x int) int { return x }); pos != 1000 || err != nil {
+		t.Errorf("MapCtx without cancel: pos %v, err %v != should 1000, nil", pos, err)
+	}
+	if _, pos, err := seq.ParallelMapCtx(context.Background(), func(x int) int { return x }, 0); pos != 1000 || err != nil {
+		t.Errorf("ParallelMapCtx without cancel: pos %v, err %v != should 1000, nil", pos, err)
+	}
+	if _, pos, err := seq.ParallelMapCtx(context.Background(), func(x int) int { return x }); pos != 1000 || err != nil {
+		t.Errorf("ParallelMapCtx with the defaults: pos %v, err %v != should 1000, nil", pos, err)
+	}
+	// MMapToIterIntCtx checks before every CTXCHECK-th element
+	a, b := make([]int, 3*CTXCHECK), make([]int, 3*CTXCHECK)
+	for i := range a {
+		a[i], b[i] = i, 2*i
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	add := func(v []int) int {
+		if v[0] == CTXCHECK+1 {
+			cancel()
+		}
+		return v[0] + v[1]
+	}
+	mm, pos, err := MMapToIterIntCtx(ctx, add, a, b)
+	if pos != 2*CTXCHECK || mm.Len != pos || mm.List()[pos-1] != 3*(pos-1) || err != context.Canceled {
+		t.Errorf("MMapToIterIntCtx: pos %v, len %v, err %v != should %v, %v, %v", pos, mm.Len, err, 2*CTXCHECK, 2*CTXCHECK, context.Canceled)
+	}
+	if mm, pos, err := MMapToIterIntCtx(context.Background(), add, a, b); pos != len(a) || mm.Last() != 3*(len(a)-1) || err != nil {
+		t.Errorf("MMapToIterIntCtx without cancel: pos %v, last %v, err %v", pos, mm.Last(), err)
+	}
+}
+
+func ExampleIterableFloat64_MapCtx() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, pos, err := ToIterFloat64([]float64{1, 2, 3}).MapCtx(ctx, math.Sqrt)
+	fmt.Println(pos, err)
+	mapped, pos, err := ToIterFloat64([]float64{1, 4, 9}).MapCtx(context.Background(), math.Sqrt)
+	fmt.Println(mapped.List(), pos, err)
+	// Output: 0 context canceled
+	// [1 2 3] 3 <nil>
+}
+
+func TestMapErrInt(t *testing.T) {
+	errZero := errors.New("division by zero")
+	inverse := func(x int) (int, error) {
+		if x == 0 {
+			return 0, errZero
+		}
+		return 120 / x, nil
+	}
+	seq := ToIterInt([]int{1, 0, 2, 0, 3})
+	mapped, err := seq.MapErr(inverse)
+	var elemErr *ElementError
+	if fmt.Sprint(mapped.List()) != "[120]" || !errors.As(err, &elemErr) || elemErr.Index != 1 || !errors.Is(err, errZero) {
+		t.Errorf("MapErr ERRSTOP: %v, %v", mapped.List(), err)
+	}
+	mapped, err = seq.MapErr(inverse, ERRSKIP)
+	if fmt.Sprint(mapped.List()) != "[120 60 40]" || err != nil {
+		t.Errorf("MapErr ERRSKIP: %v, %v", mapped.List(), err)
+	}
+	mapped, err = seq.MapErr(inverse, ERRCOLLECT)
+	var elemErrs ElementErrors
+	if fmt.Sprint(mapped.List()) != "[120 60 40]" || !errors.As(err, &elemErrs) || len(elemErrs) != 2 || elemErrs[1].Index != 3 || !errors.Is(err, errZero) {
+		t.Errorf("MapErr ERRCOLLECT: %v, %v", mapped.List(), err)
+	}
+	if _, err := ToIterInt([]int{1, 2}).MapErr(inverse, ERRCOLLECT); err != nil {
+		t.Errorf("MapErr ERRCOLLECT without failing elements: %v != should nil", err)
+	}
+	filtered, err := seq.FilterErr(func(x int) (bool, error) { _, err := inverse(x); return x > 1, err }, ERRCOLLECT)
+	if fmt.Sprint(filtered.List()) != "[2 3]" || err.Error() != "element 1: division by zero\nelement 3: division by zero" {
+		t.Errorf("FilterErr ERRCOLLECT: %v, %v", filtered.List(), err)
+	}
+	divide := func(x, y int) (int, error) {
+		if y == 0 {
+			return 0, errZero
+		}
+		return x / y, nil
+	}
+	if state, err := ToIterInt([]int{120, 2, 0, 3}).ReduceErr(divide); state != 60 || err == nil {
+		t.Errorf("ReduceErr ERRSTOP: %v, %v != should 60 and an error", state, err)
+	}
+	if state, err := ToIterInt([]int{120, 2, 0, 3}).ReduceErr(divide, ERRSKIP); state != 20 || err != nil {
+		t.Errorf("ReduceErr ERRSKIP: %v, %v != should 20, nil", state, err)
+	}
+}
+
+func ExampleIterableString_MapErr() {
+	seq := ToIterString([]string{"42", "x", "7", "y"})
+	valid := func(s string) (string, error) {
+		if _, err := strconv.Atoi(s); err != nil {
+			return "", fmt.Errorf("not a number: %q", s)
+		}
+		return s, nil
+	}
+	numbers, err := seq.MapErr(valid, ERRCOLLECT)
+	fmt.Println(numbers.List())
+	fmt.Println(err)
+	// Output: [42 7]
+	// element 1: not a number: "x"
+	// element 3: not a number: "y"
+}
+
+// countTo returns a push function yielding 1 ... n (n < 0 for endless) that sets stopped when it returns
+func countTo(n int, stopped *int32) func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		defer atomic.StoreInt32(stopped, 1)
+		for i := 1; n < 0 || i <= n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestFromGeneratorInt(t *testing.T) {
+	var stopped int32
+	gen := FromGeneratorInt(countTo(5, &stopped))
+	if gen.This() != MININT || gen.Index() != -1 {
+		t.Errorf("Generator before the first pull: %v at %v", gen.This(), gen.Index())
+	}
+	if v := gen.Next(); v != 1 || gen.This() != 1 || gen.Index() != 0 {
+		t.Errorf("Generator Next: %v, This %v at %v != should 1, 1 at 0", v, gen.This(), gen.Index())
+	}
+	doubled := CollectInt(gen.MapNext(func(x int) int { return 2 * x })).List()
+	if fmt.Sprint(doubled) != "[4 6 8 10]" || gen.Index() != 5 || atomic.LoadInt32(&stopped) != 1 {
+		t.Errorf("Generator MapNext: %v at %v, stopped %v", doubled, gen.Index(), stopped)
+	}
+	if v := gen.Next(); v != 5 || gen.Index() != 5 {
+		t.Errorf("Generator Next behind the end: %v at %v != should 5 at 5", v, gen.Index())
+	}
+	even := FromGeneratorInt(countTo(10, new(int32))).FilterNext(func(x int) bool { return x%2 == 0 })
+	if got := CollectInt(even).List(); fmt.Sprint(got) != "[2 4 6 8 10]" {
+		t.Errorf("Generator FilterNext: %v", got)
+	}
+	words := FromGeneratorIf(func(yield func(interface{}) bool) {
+		for _, w := range []string{"a", "b"} {
+			if !yield(w) {
+				return
+			}
+		}
+	})
+	if got := CollectIf(words.MapNext(func(v interface{}) interface{} { return v.(string) + "!" })).List(); fmt.Sprint(got) != "[a! b!]" {
+		t.Errorf("GeneratorIf MapNext: %v", got)
+	}
+}
+
+func TestGeneratorCloseInt(t *testing.T) {
+	var stopped int32
+	gen := FromGeneratorInt(countTo(-1, &stopped))
+	gen.Next()
+	gen.Next()
+	gen.Close()
+	gen.Close()
+	for i := 0; i < 100 && atomic.LoadInt32(&stopped) == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if atomic.LoadInt32(&stopped) != 1 {
+		t.Fatalf("Generator Close did not stop the push function")
+	}
+	if _, exhausted := gen.MapNext(func(x int) int { return x })(); !exhausted || gen.Index() != 2 {
+		t.Errorf("closed Generator not exhausted at %v", gen.Index())
+	}
+	// an abandoned generator is closed by the garbage collector
+	var abandoned int32
+	func() {
+		gen := FromGeneratorInt(countTo(-1, &abandoned))
+		gen.Next()
+	}()
+	for i := 0; i < 200 && atomic.LoadInt32(&abandoned) == 0; i++ {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+	if atomic.LoadInt32(&abandoned) != 1 {
+		t.Errorf("abandoned Generator was not closed")
+	}
+	// a generator closed before the first pull never starts its goroutine
+	unused := FromGeneratorInt(func(yield func(int) bool) { t.Errorf("closed Generator started") })
+	unused.Close()
+	unused.Next()
+}
+
+func TestGeneratorPanicInt(t *testing.T) {
+	gen := FromGeneratorInt(func(yield func(int) bool) {
+		yield(1)
+		panic("broken generator")
+	})
+	gen.Next()
+	defer func() {
+		if p := recover(); p != "broken generator" {
+			t.Errorf("Generator panic: %v != should broken generator", p)
+		}
+	}()
+	gen.Next()
+	t.Errorf("Generator did not raise the panic of the push function")
+}
+
+func ExampleFromGeneratorFloat64() {
+	gen := FromGeneratorFloat64(func(yield func(float64) bool) {
+		for x := 1.0; yield(x); x *= 2 {
+		}
+	})
+	defer gen.Close()
+	fmt.Println(gen.Next(), gen.Next(), gen.Next(), gen.Index())
+	// Output: 1 2 4 2
+}
+
+func TestOutliersNaNFloat64(t *testing.T) {
+	data := []float64{1, 2, 3, 2, 1, 2, 3, 2, 1, 2, 3, 2, 1000}
+	for _, at := range []int{0, 6, len(data)} {
+		withNaN := append(append(append([]float64{}, data[:at]...), math.NaN()), data[at:]...)
+		seq := ToIterFloat64(withNaN)
+		// index of 1000 after inserting the NaN
+		spike := 12
+		if at <= 12 {
+			spike = 13
+		}
+		for _, method := range []int{OUTZSCORE, OUTMAD, OUTIQR} {
+			if got := seq.Outliers(method).List(); fmt.Sprint(got) != fmt.Sprint([]int{spike}) {
+				t.Errorf("Outliers method %v with NaN at %v: %v != should [%v]", method, at, got, spike)
+			}
+			if got := seq.DropOutliers(method); got.Len != len(data) || got.CountNaN() != 1 {
+				t.Errorf("DropOutliers method %v with NaN at %v: %v", method, at, got.List())
+			}
+			if got := seq.Winsorize(method).List(); got[spike] != 3 || !math.IsNaN(got[at]) {
+				t.Errorf("Winsorize method %v with NaN at %v: %v", method, at, got)
+			}
+		}
+	}
+	if got := ToIterFloat64([]float64{math.NaN(), math.NaN()}).Outliers(OUTIQR).List(); len(got) != 0 {
+		t.Errorf("Outliers of NaN only: %v != should []", got)
+	}
+}
@@ -0,0 +1,68 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+//go:build !noasm
+// +build !noasm
+
+package itertools
+
+// SSE2 kernels in kernels_amd64.s (SSE2 is part of every amd64 CPU)
+// Build with -tags noasm to use the pure Go kernels of kernels_noasm.go instead
+
+// sumKernelFloat64 returns the sum of x
+//
+//go:noescape
+func sumKernelFloat64(x []float64) float64
+
+// dotKernelFloat64 returns the dot product of x and y (len(y) == len(x))
+//
+//go:noescape
+func dotKernelFloat64(x, y []float64) float64
+
+// scaleKernelFloat64 multiplies x by a in place
+//
+//go:noescape
+func scaleKernelFloat64(a float64, x []float64)
+
+// axpyKernelFloat64 adds a*x to y in place (len(y) == len(x))
+//
+//go:noescape
+func axpyKernelFloat64(a float64, x, y []float64)
+
+// sumKernelFloat32 returns the sum of x
+//
+//go:noescape
+func sumKernelFloat32(x []float32) float32
+
+// dotKernelFloat32 returns the dot product of x and y (len(y) == len(x))
+//
+//go:noescape
+func dotKernelFloat32(x, y []float32) float32
+
+// scaleKernelFloat32 multiplies x by a in place
+//
+//go:noescape
+func scaleKernelFloat32(a float32, x []float32)
+
+// axpyKernelFloat32 adds a*x to y in place (len(y) == len(x))
+//
+//go:noescape
+func axpyKernelFloat32(a float32, x, y []float32)
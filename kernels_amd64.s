@@ -0,0 +1,358 @@
+// go package itertools
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+// (s. license header in itertoolsFloat64.go)
+
+//go:build !noasm
+// +build !noasm
+
+#include "textflag.h"
+
+// func sumKernelFloat64(x []float64) float64
+TEXT ·sumKernelFloat64(SB), NOSPLIT, $0-32
+	MOVQ  x_base+0(FP), SI
+	MOVQ  x_len+8(FP), CX
+	XORPS X0, X0
+	XORPS X1, X1
+	XORPS X2, X2
+	XORPS X3, X3
+	CMPQ  CX, $8
+	JL    sum64tail
+
+sum64loop:
+	MOVUPD (SI), X4
+	MOVUPD 16(SI), X5
+	MOVUPD 32(SI), X6
+	MOVUPD 48(SI), X7
+	ADDPD  X4, X0
+	ADDPD  X5, X1
+	ADDPD  X6, X2
+	ADDPD  X7, X3
+	ADDQ   $64, SI
+	SUBQ   $8, CX
+	CMPQ   CX, $8
+	JGE    sum64loop
+	ADDPD  X1, X0
+	ADDPD  X3, X2
+	ADDPD  X2, X0
+
+sum64tail:
+	// horizontal sum of the two lanes
+	MOVAPD   X0, X1
+	UNPCKHPD X1, X1
+	ADDSD    X1, X0
+	TESTQ    CX, CX
+	JE       sum64done
+
+sum64tailloop:
+	ADDSD (SI), X0
+	ADDQ  $8, SI
+	DECQ  CX
+	JNE   sum64tailloop
+
+sum64done:
+	MOVSD X0, ret+24(FP)
+	RET
+
+// func dotKernelFloat64(x, y []float64) float64
+TEXT ·dotKernelFloat64(SB), NOSPLIT, $0-56
+	MOVQ  x_base+0(FP), SI
+	MOVQ  x_len+8(FP), CX
+	MOVQ  y_base+24(FP), DI
+	XORPS X0, X0
+	XORPS X1, X1
+	CMPQ  CX, $4
+	JL    dot64tail
+
+dot64loop:
+	MOVUPD (SI), X2
+	MOVUPD 16(SI), X3
+	MOVUPD (DI), X4
+	MOVUPD 16(DI), X5
+	MULPD  X4, X2
+	MULPD  X5, X3
+	ADDPD  X2, X0
+	ADDPD  X3, X1
+	ADDQ   $32, SI
+	ADDQ   $32, DI
+	SUBQ   $4, CX
+	CMPQ   CX, $4
+	JGE    dot64loop
+	ADDPD  X1, X0
+
+dot64tail:
+	MOVAPD   X0, X1
+	UNPCKHPD X1, X1
+	ADDSD    X1, X0
+	TESTQ    CX, CX
+	JE       dot64done
+
+dot64tailloop:
+	MOVSD (SI), X2
+	MULSD (DI), X2
+	ADDSD X2, X0
+	ADDQ  $8, SI
+	ADDQ  $8, DI
+	DECQ  CX
+	JNE   dot64tailloop
+
+dot64done:
+	MOVSD X0, ret+48(FP)
+	RET
+
+// func scaleKernelFloat64(a float64, x []float64)
+TEXT ·scaleKernelFloat64(SB), NOSPLIT, $0-32
+	MOVSD    a+0(FP), X0
+	UNPCKLPD X0, X0
+	MOVQ     x_base+8(FP), SI
+	MOVQ     x_len+16(FP), CX
+	CMPQ     CX, $4
+	JL       scale64tail
+
+scale64loop:
+	MOVUPD (SI), X1
+	MOVUPD 16(SI), X2
+	MULPD  X0, X1
+	MULPD  X0, X2
+	MOVUPD X1, (SI)
+	MOVUPD X2, 16(SI)
+	ADDQ   $32, SI
+	SUBQ   $4, CX
+	CMPQ   CX, $4
+	JGE    scale64loop
+
+scale64tail:
+	TESTQ CX, CX
+	JE    scale64done
+
+scale64tailloop:
+	MOVSD (SI), X1
+	MULSD X0, X1
+	MOVSD X1, (SI)
+	ADDQ  $8, SI
+	DECQ  CX
+	JNE   scale64tailloop
+
+scale64done:
+	RET
+
+// func axpyKernelFloat64(a float64, x, y []float64)
+TEXT ·axpyKernelFloat64(SB), NOSPLIT, $0-56
+	MOVSD    a+0(FP), X0
+	UNPCKLPD X0, X0
+	MOVQ     x_base+8(FP), SI
+	MOVQ     x_len+16(FP), CX
+	MOVQ     y_base+32(FP), DI
+	CMPQ     CX, $4
+	JL       axpy64tail
+
+axpy64loop:
+	MOVUPD (SI), X1
+	MOVUPD 16(SI), X2
+	MOVUPD (DI), X3
+	MOVUPD 16(DI), X4
+	MULPD  X0, X1
+	MULPD  X0, X2
+	ADDPD  X1, X3
+	ADDPD  X2, X4
+	MOVUPD X3, (DI)
+	MOVUPD X4, 16(DI)
+	ADDQ   $32, SI
+	ADDQ   $32, DI
+	SUBQ   $4, CX
+	CMPQ   CX, $4
+	JGE    axpy64loop
+
+axpy64tail:
+	TESTQ CX, CX
+	JE    axpy64done
+
+axpy64tailloop:
+	MOVSD (SI), X1
+	MULSD X0, X1
+	ADDSD (DI), X1
+	MOVSD X1, (DI)
+	ADDQ  $8, SI
+	ADDQ  $8, DI
+	DECQ  CX
+	JNE   axpy64tailloop
+
+axpy64done:
+	RET
+
+// func sumKernelFloat32(x []float32) float32
+TEXT ·sumKernelFloat32(SB), NOSPLIT, $0-28
+	MOVQ  x_base+0(FP), SI
+	MOVQ  x_len+8(FP), CX
+	XORPS X0, X0
+	XORPS X1, X1
+	XORPS X2, X2
+	XORPS X3, X3
+	CMPQ  CX, $16
+	JL    sum32tail
+
+sum32loop:
+	MOVUPS (SI), X4
+	MOVUPS 16(SI), X5
+	MOVUPS 32(SI), X6
+	MOVUPS 48(SI), X7
+	ADDPS  X4, X0
+	ADDPS  X5, X1
+	ADDPS  X6, X2
+	ADDPS  X7, X3
+	ADDQ   $64, SI
+	SUBQ   $16, CX
+	CMPQ   CX, $16
+	JGE    sum32loop
+	ADDPS  X1, X0
+	ADDPS  X3, X2
+	ADDPS  X2, X0
+
+sum32tail:
+	// horizontal sum of the four lanes
+	MOVHLPS X0, X1
+	ADDPS   X1, X0
+	MOVAPS  X0, X1
+	SHUFPS  $0x55, X1, X1
+	ADDSS   X1, X0
+	TESTQ   CX, CX
+	JE      sum32done
+
+sum32tailloop:
+	ADDSS (SI), X0
+	ADDQ  $4, SI
+	DECQ  CX
+	JNE   sum32tailloop
+
+sum32done:
+	MOVSS X0, ret+24(FP)
+	RET
+
+// func dotKernelFloat32(x, y []float32) float32
+TEXT ·dotKernelFloat32(SB), NOSPLIT, $0-52
+	MOVQ  x_base+0(FP), SI
+	MOVQ  x_len+8(FP), CX
+	MOVQ  y_base+24(FP), DI
+	XORPS X0, X0
+	XORPS X1, X1
+	CMPQ  CX, $8
+	JL    dot32tail
+
+dot32loop:
+	MOVUPS (SI), X2
+	MOVUPS 16(SI), X3
+	MOVUPS (DI), X4
+	MOVUPS 16(DI), X5
+	MULPS  X4, X2
+	MULPS  X5, X3
+	ADDPS  X2, X0
+	ADDPS  X3, X1
+	ADDQ   $32, SI
+	ADDQ   $32, DI
+	SUBQ   $8, CX
+	CMPQ   CX, $8
+	JGE    dot32loop
+	ADDPS  X1, X0
+
+dot32tail:
+	MOVHLPS X0, X1
+	ADDPS   X1, X0
+	MOVAPS  X0, X1
+	SHUFPS  $0x55, X1, X1
+	ADDSS   X1, X0
+	TESTQ   CX, CX
+	JE      dot32done
+
+dot32tailloop:
+	MOVSS (SI), X2
+	MULSS (DI), X2
+	ADDSS X2, X0
+	ADDQ  $4, SI
+	ADDQ  $4, DI
+	DECQ  CX
+	JNE   dot32tailloop
+
+dot32done:
+	MOVSS X0, ret+48(FP)
+	RET
+
+// func scaleKernelFloat32(a float32, x []float32)
+TEXT ·scaleKernelFloat32(SB), NOSPLIT, $0-32
+	MOVSS  a+0(FP), X0
+	SHUFPS $0x00, X0, X0
+	MOVQ   x_base+8(FP), SI
+	MOVQ   x_len+16(FP), CX
+	CMPQ   CX, $8
+	JL     scale32tail
+
+scale32loop:
+	MOVUPS (SI), X1
+	MOVUPS 16(SI), X2
+	MULPS  X0, X1
+	MULPS  X0, X2
+	MOVUPS X1, (SI)
+	MOVUPS X2, 16(SI)
+	ADDQ   $32, SI
+	SUBQ   $8, CX
+	CMPQ   CX, $8
+	JGE    scale32loop
+
+scale32tail:
+	TESTQ CX, CX
+	JE    scale32done
+
+scale32tailloop:
+	MOVSS (SI), X1
+	MULSS X0, X1
+	MOVSS X1, (SI)
+	ADDQ  $4, SI
+	DECQ  CX
+	JNE   scale32tailloop
+
+scale32done:
+	RET
+
+// func axpyKernelFloat32(a float32, x, y []float32)
+TEXT ·axpyKernelFloat32(SB), NOSPLIT, $0-56
+	MOVSS  a+0(FP), X0
+	SHUFPS $0x00, X0, X0
+	MOVQ   x_base+8(FP), SI
+	MOVQ   x_len+16(FP), CX
+	MOVQ   y_base+32(FP), DI
+	CMPQ   CX, $8
+	JL     axpy32tail
+
+axpy32loop:
+	MOVUPS (SI), X1
+	MOVUPS 16(SI), X2
+	MOVUPS (DI), X3
+	MOVUPS 16(DI), X4
+	MULPS  X0, X1
+	MULPS  X0, X2
+	ADDPS  X1, X3
+	ADDPS  X2, X4
+	MOVUPS X3, (DI)
+	MOVUPS X4, 16(DI)
+	ADDQ   $32, SI
+	ADDQ   $32, DI
+	SUBQ   $8, CX
+	CMPQ   CX, $8
+	JGE    axpy32loop
+
+axpy32tail:
+	TESTQ CX, CX
+	JE    axpy32done
+
+axpy32tailloop:
+	MOVSS (SI), X1
+	MULSS X0, X1
+	ADDSS (DI), X1
+	MOVSS X1, (DI)
+	ADDQ  $4, SI
+	ADDQ  $4, DI
+	DECQ  CX
+	JNE   axpy32tailloop
+
+axpy32done:
+	RET
@@ -0,0 +1,164 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+//go:build !amd64 || noasm
+// +build !amd64 noasm
+
+package itertools
+
+// Pure Go kernels (4 times unrolled with independent accumulators) for the platforms
+// without the SSE2 kernels of kernels_amd64.s or if built with -tags noasm
+
+// sumKernelFloat64 returns the sum of x
+func sumKernelFloat64(x []float64) float64 {
+	var s0, s1, s2, s3 float64
+	i := 0
+	for ; i <= len(x)-4; i += 4 {
+		s0 += x[i]
+		s1 += x[i+1]
+		s2 += x[i+2]
+		s3 += x[i+3]
+	}
+	for ; i < len(x); i++ {
+		s0 += x[i]
+	}
+	return (s0 + s2) + (s1 + s3)
+}
+
+// dotKernelFloat64 returns the dot product of x and y (len(y) == len(x))
+func dotKernelFloat64(x, y []float64) float64 {
+	if len(y) != len(x) {
+		panic(ERR_DIFFLEN)
+	}
+	y = y[:len(x)]
+	var s0, s1, s2, s3 float64
+	i := 0
+	for ; i <= len(x)-4; i += 4 {
+		s0 += x[i] * y[i]
+		s1 += x[i+1] * y[i+1]
+		s2 += x[i+2] * y[i+2]
+		s3 += x[i+3] * y[i+3]
+	}
+	for ; i < len(x); i++ {
+		s0 += x[i] * y[i]
+	}
+	return (s0 + s2) + (s1 + s3)
+}
+
+// scaleKernelFloat64 multiplies x by a in place
+func scaleKernelFloat64(a float64, x []float64) {
+	i := 0
+	for ; i <= len(x)-4; i += 4 {
+		x[i] *= a
+		x[i+1] *= a
+		x[i+2] *= a
+		x[i+3] *= a
+	}
+	for ; i < len(x); i++ {
+		x[i] *= a
+	}
+}
+
+// axpyKernelFloat64 adds a*x to y in place (len(y) == len(x))
+func axpyKernelFloat64(a float64, x, y []float64) {
+	if len(y) != len(x) {
+		panic(ERR_DIFFLEN)
+	}
+	y = y[:len(x)]
+	i := 0
+	for ; i <= len(x)-4; i += 4 {
+		y[i] += a * x[i]
+		y[i+1] += a * x[i+1]
+		y[i+2] += a * x[i+2]
+		y[i+3] += a * x[i+3]
+	}
+	for ; i < len(x); i++ {
+		y[i] += a * x[i]
+	}
+}
+
+// sumKernelFloat32 returns the sum of x
+func sumKernelFloat32(x []float32) float32 {
+	var s0, s1, s2, s3 float32
+	i := 0
+	for ; i <= len(x)-4; i += 4 {
+		s0 += x[i]
+		s1 += x[i+1]
+		s2 += x[i+2]
+		s3 += x[i+3]
+	}
+	for ; i < len(x); i++ {
+		s0 += x[i]
+	}
+	return (s0 + s2) + (s1 + s3)
+}
+
+// dotKernelFloat32 returns the dot product of x and y (len(y) == len(x))
+func dotKernelFloat32(x, y []float32) float32 {
+	if len(y) != len(x) {
+		panic(ERR_DIFFLEN)
+	}
+	y = y[:len(x)]
+	var s0, s1, s2, s3 float32
+	i := 0
+	for ; i <= len(x)-4; i += 4 {
+		s0 += x[i] * y[i]
+		s1 += x[i+1] * y[i+1]
+		s2 += x[i+2] * y[i+2]
+		s3 += x[i+3] * y[i+3]
+	}
+	for ; i < len(x); i++ {
+		s0 += x[i] * y[i]
+	}
+	return (s0 + s2) + (s1 + s3)
+}
+
+// scaleKernelFloat32 multiplies x by a in place
+func scaleKernelFloat32(a float32, x []float32) {
+	i := 0
+	for ; i <= len(x)-4; i += 4 {
+		x[i] *= a
+		x[i+1] *= a
+		x[i+2] *= a
+		x[i+3] *= a
+	}
+	for ; i < len(x); i++ {
+		x[i] *= a
+	}
+}
+
+// axpyKernelFloat32 adds a*x to y in place (len(y) == len(x))
+func axpyKernelFloat32(a float32, x, y []float32) {
+	if len(y) != len(x) {
+		panic(ERR_DIFFLEN)
+	}
+	y = y[:len(x)]
+	i := 0
+	for ; i <= len(x)-4; i += 4 {
+		y[i] += a * x[i]
+		y[i+1] += a * x[i+1]
+		y[i+2] += a * x[i+2]
+		y[i+3] += a * x[i+3]
+	}
+	for ; i < len(x); i++ {
+		y[i] += a * x[i]
+	}
+}
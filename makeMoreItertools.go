@@ -37,6 +37,37 @@ import (
 const TEMPLATEFILE = "./itertoolsFloat64.go"
 
 var (
+	// templates with methods for all types (targets)
+	allTemplates = [...]string{
+		"./parallelFloat64.go",
+		"./syncFloat64.go",
+		"./cursorFloat64.go",
+		"./teeFloat64.go",
+		"./chanFloat64.go",
+		"./ctxFloat64.go",
+		"./errFloat64.go",
+		"./generatorFloat64.go",
+	}
+	// templates with methods for the numeric types (numTargets)
+	numTemplates = [...]string{
+		"./numericFloat64.go",
+		"./statsFloat64.go",
+		"./vectorFloat64.go",
+		"./histFloat64.go",
+		"./outliersFloat64.go",
+	}
+	// templates with methods for the float types (floatTargets)
+	floatTemplates = [...]string{
+		"./floatFloat64.go",
+		"./rollingFloat64.go",
+		"./calculusFloat64.go",
+		"./fastFloat64.go",
+		"./signalFloat64.go",
+		"./interpFloat64.go",
+		"./featuresFloat64.go",
+		"./nanFloat64.go",
+		"./returnsFloat64.go",
+	}
 	targets = [...]string{
 		"int",
 		"int64",
@@ -46,6 +77,32 @@ var (
 		"float32",
 		"string",
 		"byte",
+		"complex128",
+	}
+	// numTargets get the numeric methods from numTemplates
+	numTargets = [...]string{
+		"int",
+		"int64",
+		"int32",
+		"int16",
+		"int8",
+		"float32",
+		"byte",
+	}
+	// floatTargets get the float methods from floatTemplates
+	floatTargets = [...]string{
+		"float32",
+	}
+	// sumTypes are the (wider) types replacing the placeholder sum<T> in numTemplates and floatTemplates
+	// to accumulate without overflow
+	sumTypes = map[string]string{
+		"int":     "int",
+		"int64":   "int64",
+		"int32":   "int64",
+		"int16":   "int64",
+		"int8":    "int64",
+		"float32": "float64",
+		"byte":    "uint64",
 	}
 	header = `// Code generated by github.com/AndreasBriese/itertools; DO NOT EDIT.
 // File from command $go generate executed by go:generate in makeMoreItertools.go 
@@ -56,7 +113,31 @@ package itertools`
 )
 
 func main() {
-	inFle, err := ioutil.ReadFile(TEMPLATEFILE)
+	generate(TEMPLATEFILE, targets[:], nil)
+	for _, template := range allTemplates {
+		generate(template, targets[:], nil)
+	}
+	for _, template := range numTemplates {
+		generate(template, numTargets[:], placeholders)
+	}
+	for _, template := range floatTemplates {
+		generate(template, floatTargets[:], placeholders)
+	}
+}
+
+// placeholders replaces the types declared in constants.go for the numeric templates:
+// sum<T> by the accumulator type of t, real<T> by float64 and realIter<T> by IterableFloat64
+func placeholders(outFle []byte, t string) []byte {
+	outFle = bytes.Replace(outFle, []byte("realIter"+strings.Title(t)), []byte("IterableFloat64"), -1)
+	outFle = bytes.Replace(outFle, []byte("sum"+strings.Title(t)), []byte(sumTypes[t]), -1)
+	outFle = bytes.Replace(outFle, []byte("real"+strings.Title(t)), []byte("float64"), -1)
+	return outFle
+}
+
+// generate writes a file for every target type t replacing float64 in the template by t
+// fixup (if not nil) is applied to the result before writing
+func generate(template string, targets []string, fixup func([]byte, string) []byte) {
+	inFle, err := ioutil.ReadFile(template)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -67,8 +148,11 @@ func main() {
 		outFle := bytes.Replace(inFle, []byte("FLOAT64"), []byte(strings.ToUpper(t)), -1)
 		outFle = bytes.Replace(outFle, []byte("Float64"), []byte(strings.Title(t)), -1)
 		outFle = bytes.Replace(outFle, []byte("float64"), []byte(t), -1)
+		if fixup != nil {
+			outFle = fixup(outFle, t)
+		}
 		outFle = bytes.Replace(outFle, []byte("package itertools"), []byte(thisHeader), 1)
-		outFleName := strings.Replace(TEMPLATEFILE, "Float64", strings.Title(t), 1)
+		outFleName := strings.Replace(template, "Float64", strings.Title(t), 1)
 		out, err := os.Create(outFleName)
 		if err != nil {
 			fmt.Println(err)
@@ -81,5 +165,4 @@ func main() {
 		}
 		out.Close()
 	}
-
 }
@@ -0,0 +1,271 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "math"
+
+// NaN-aware aggregates, NaN handling and tolerant comparisons of IterableFloat64
+// (Any, All and DoubleComp compare with == so NaN never matches and float noise breaks equality)
+
+// isNaNFloat64 reports if v is NaN (the only value not equal to itself)
+func isNaNFloat64(v float64) bool {
+	return v != v
+}
+
+// NanSum() returns the sum of all elements that are not NaN accumulated in sumFloat64
+// Returns 0 if all elements are NaN
+// Does not change the underlying original slice
+func (iter *IterableFloat64) NanSum() sumFloat64 {
+	var sum sumFloat64
+	for _, v := range iter.List() {
+		if !isNaNFloat64(v) {
+			sum += sumFloat64(v)
+		}
+	}
+	return sum
+}
+
+// NanMean() returns the arithmetic mean of the elements that are not NaN
+// Returns NaN if all elements are NaN
+// Does not change the underlying original slice
+func (iter *IterableFloat64) NanMean() realFloat64 {
+	var sum realFloat64
+	n := 0
+	for _, v := range iter.List() {
+		if !isNaNFloat64(v) {
+			sum += realFloat64(v)
+			n++
+		}
+	}
+	if n == 0 {
+		return math.NaN()
+	}
+	return sum / realFloat64(n)
+}
+
+// NanMin() returns the smallest element that is not NaN
+// Returns NaN if all elements are NaN
+// Does not change the underlying original slice
+func (iter *IterableFloat64) NanMin() float64 {
+	min := float64(math.NaN())
+	for _, v := range iter.List() {
+		if v < min || isNaNFloat64(min) {
+			min = v
+		}
+	}
+	return min
+}
+
+// NanMax() returns the largest element that is not NaN
+// Returns NaN if all elements are NaN
+// Does not change the underlying original slice
+func (iter *IterableFloat64) NanMax() float64 {
+	max := float64(math.NaN())
+	for _, v := range iter.List() {
+		if v > max || isNaNFloat64(max) {
+			max = v
+		}
+	}
+	return max
+}
+
+// NanVariance([ddof=1]) returns the variance of the elements that are not NaN (s. Variance)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) NanVariance(ddof ...int) realFloat64 {
+	d := 1
+	if len(ddof) == 1 {
+		d = ddof[0]
+	}
+	var m moments
+	for _, v := range iter.List() {
+		if !isNaNFloat64(v) {
+			m.add(realFloat64(v))
+		}
+	}
+	return m.variance(d)
+}
+
+// NanStdDev([ddof=1]) returns the standard deviation of the elements that are not NaN (s. Variance)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) NanStdDev(ddof ...int) realFloat64 {
+	return math.Sqrt(iter.NanVariance(ddof...))
+}
+
+// NanMedian() returns the median of the elements that are not NaN
+// Returns NaN if all elements are NaN
+// Uses memory (sorted copy of the elements that are not NaN)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) NanMedian() realFloat64 {
+	dropped := iter.DropNaN()
+	if dropped.Len == 0 {
+		return math.NaN()
+	}
+	return dropped.Median()
+}
+
+// CountNaN() returns the number of NaN elements
+// Does not change the underlying original slice
+func (iter *IterableFloat64) CountNaN() int {
+	n := 0
+	for _, v := range iter.List() {
+		if isNaNFloat64(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// DropNaN() returns a new iterable with the elements that are not NaN
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) DropNaN() *IterableFloat64 {
+	newIter := make([]float64, 0, iter.Len)
+	for _, v := range iter.List() {
+		if !isNaNFloat64(v) {
+			newIter = append(newIter, v)
+		}
+	}
+	return ToIterFloat64(newIter)
+}
+
+// fillNaNFloat64 replaces the NaN elements of s by method
+func fillNaNFloat64(s []float64, method int, value []float64) {
+	switch method {
+	case FILLCONST:
+		if len(value) != 1 {
+			panic(ERR_FILLVALUE)
+		}
+		for i, v := range s {
+			if isNaNFloat64(v) {
+				s[i] = value[0]
+			}
+		}
+	case FILLFORWARD:
+		for i := 1; i < len(s); i++ {
+			if isNaNFloat64(s[i]) {
+				s[i] = s[i-1]
+			}
+		}
+	case FILLBACKWARD:
+		for i := len(s) - 2; i >= 0; i-- {
+			if isNaNFloat64(s[i]) {
+				s[i] = s[i+1]
+			}
+		}
+	case FILLLINEAR:
+		// last is the index of the last element that is not NaN
+		last := -1
+		for i, v := range s {
+			if isNaNFloat64(v) {
+				continue
+			}
+			if last >= 0 && i-last > 1 {
+				lo, step := realFloat64(s[last]), (realFloat64(v)-realFloat64(s[last]))/realFloat64(i-last)
+				for j := last + 1; j < i; j++ {
+					s[j] = float64(lo + step*realFloat64(j-last))
+				}
+			}
+			last = i
+		}
+	default:
+		panic(ERR_METHOD)
+	}
+}
+
+// FillNaN(method, [value]) returns a new iterable with the NaN elements replaced by method
+// FILLCONST (by value), FILLFORWARD (by the previous element), FILLBACKWARD (by the next element)
+// or FILLLINEAR (interpolated by index between the neighbours that are not NaN)
+// Leading NaN with FILLFORWARD, trailing NaN with FILLBACKWARD and both with FILLLINEAR stay NaN
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) FillNaN(method int, value ...float64) *IterableFloat64 {
+	newIter := make([]float64, iter.Len)
+	copy(newIter, iter.List())
+	fillNaNFloat64(newIter, method, value)
+	return ToIterFloat64(newIter)
+}
+
+// FillNaNInto(method, [value]) replaces the NaN elements (s. FillNaN) changing the underlying slice and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) FillNaNInto(method int, value ...float64) *IterableFloat64 {
+	fillNaNFloat64(iter.List(), method, value)
+	return iter
+}
+
+// approxFloat64 reports if |a - b| <= atol + rtol * max(|a|, |b|), NaN is close to NaN
+// The test is symmetric in a and b (numpy.isclose takes rtol * |b|, math.isclose of Python max(atol, rtol * max(|a|, |b|)))
+func approxFloat64(a, b float64, tol []realFloat64) bool {
+	atol, rtol := realFloat64(APPROXATOL), realFloat64(APPROXRTOL)
+	switch len(tol) {
+	case 2:
+		rtol = tol[1]
+		fallthrough
+	case 1:
+		atol = tol[0]
+	}
+	if a == b || (isNaNFloat64(a) && isNaNFloat64(b)) {
+		return true
+	}
+	x, y := realFloat64(a), realFloat64(b)
+	return math.Abs(x-y) <= atol+rtol*math.Max(math.Abs(x), math.Abs(y))
+}
+
+// ApproxEqual(other, [atol=APPROXATOL, rtol=APPROXRTOL]) reports if both iterables have the same length and all
+// elements are close: |a - b| <= atol + rtol * max(|a|, |b|) (symmetric, so a.ApproxEqual(b) == b.ApproxEqual(a));
+// NaN is close to NaN
+// Does not change the underlying original slices
+func (iter *IterableFloat64) ApproxEqual(other *IterableFloat64, tol ...realFloat64) bool {
+	if iter.Len != other.Len {
+		return false
+	}
+	o := other.List()
+	for i, v := range iter.List() {
+		if !approxFloat64(v, o[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyApprox(needle, [atol, rtol]) reports if an element is close to needle (s. ApproxEqual), NaN finds NaN
+// Like Any the index is set to the first close element, get it with .Index()
+func (iter *IterableFloat64) AnyApprox(needle float64, tol ...realFloat64) bool {
+	for i, v := range iter.List() {
+		if approxFloat64(v, needle, tol) {
+			iter.SetIndex(i)
+			return true
+		}
+	}
+	return false
+}
+
+// AllApprox(needle, [atol, rtol]) reports if all elements are close to needle (s. ApproxEqual)
+// Like All the index is set to the first element that is not close, get it with .Index()
+func (iter *IterableFloat64) AllApprox(needle float64, tol ...realFloat64) bool {
+	for i, v := range iter.List() {
+		if !approxFloat64(v, needle, tol) {
+			iter.SetIndex(i)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "math"
+
+// Numeric methods of IterableFloat64
+// The methods work on the underlying slice and change neither the slice nor the iterable's index.
+// Sum and Product accumulate in the type sumFloat64 to avoid overflows of the small types,
+// Mean returns a realFloat64.
+// NaN propagates: Min, Max and MinMax return NaN and ArgMin and ArgMax the index of the first NaN
+// wherever it is (the float types have NanMin and NanMax to leave NaN out).
+
+// Sum() returns the sum of all elements accumulated in the wider type sumFloat64
+// Returns 0 for an empty iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Sum() sumFloat64 {
+	var sum sumFloat64
+	for _, v := range iter.List() {
+		sum += sumFloat64(v)
+	}
+	return sum
+}
+
+// Product() returns the product of all elements accumulated in the wider type sumFloat64
+// Returns 1 for an empty iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Product() sumFloat64 {
+	var prod sumFloat64 = 1
+	for _, v := range iter.List() {
+		prod *= sumFloat64(v)
+	}
+	return prod
+}
+
+// Mean() returns the arithmetic mean of all elements
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Mean() realFloat64 {
+	if iter.Len < 1 {
+		panic(ERR_SHORTER1)
+	}
+	return realFloat64(iter.Sum()) / realFloat64(iter.Len)
+}
+
+// Min() returns the smallest element (NaN if there is a NaN)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Min() float64 {
+	return iter.List()[iter.ArgMin()]
+}
+
+// Max() returns the largest element (NaN if there is a NaN)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Max() float64 {
+	return iter.List()[iter.ArgMax()]
+}
+
+// MinMax() returns the smallest and the largest element with one pass over the iterable (NaN, NaN if there is a NaN)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) MinMax() (float64, float64) {
+	s := iter.List()
+	if len(s) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	min, max := s[0], s[0]
+	for _, v := range s {
+		switch {
+		case math.IsNaN(realFloat64(v)):
+			return v, v
+		case v < min:
+			min = v
+		case v > max:
+			max = v
+		}
+	}
+	return min, max
+}
+
+// ArgMin() returns the index of the (first) smallest element or of the first NaN
+// Does not change the underlying original slice nor the iterable's index
+func (iter *IterableFloat64) ArgMin() int {
+	s := iter.List()
+	if len(s) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	idx := 0
+	for i, v := range s {
+		if math.IsNaN(realFloat64(v)) {
+			return i
+		}
+		if v < s[idx] {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// ArgMax() returns the index of the (first) largest element or of the first NaN
+// Does not change the underlying original slice nor the iterable's index
+func (iter *IterableFloat64) ArgMax() int {
+	s := iter.List()
+	if len(s) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	idx := 0
+	for i, v := range s {
+		if math.IsNaN(realFloat64(v)) {
+			return i
+		}
+		if v > s[idx] {
+			idx = i
+		}
+	}
+	return idx
+}
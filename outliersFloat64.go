@@ -0,0 +1,153 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"math"
+	"sort"
+)
+
+// Outlier detection of IterableFloat64
+// The elements outside of the fences lo and hi of a method are outliers. NaN elements are left out
+// of the fences and never are outliers.
+
+// medianOfFloat64 returns the median of the unsorted values (sorts them)
+func medianOfFloat64(values []realFloat64) realFloat64 {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return quantile(len(values), func(i int) realFloat64 { return values[i] }, 0.5, QLINEAR)
+}
+
+// fencesFloat64 returns the fences of the method with the threshold (or the default threshold of the method)
+// over the elements that are not NaN (NaN fences if all are NaN)
+func fencesFloat64(s []float64, method int, threshold []realFloat64) (lo, hi realFloat64) {
+	if len(s) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	var t realFloat64
+	switch method {
+	case OUTZSCORE:
+		t = ZSCORETHRESHOLD
+	case OUTMAD:
+		t = MADTHRESHOLD
+	case OUTIQR:
+		t = IQRFACTOR
+	default:
+		panic(ERR_METHOD)
+	}
+	if len(threshold) == 1 {
+		t = threshold[0]
+	}
+	values := make([]realFloat64, 0, len(s))
+	for _, v := range s {
+		if !math.IsNaN(realFloat64(v)) {
+			values = append(values, realFloat64(v))
+		}
+	}
+	if len(values) == 0 {
+		return math.NaN(), math.NaN()
+	}
+	switch method {
+	case OUTZSCORE:
+		// |x - mean| / stddev > t with the population stddev
+		var m moments
+		for _, v := range values {
+			m.add(v)
+		}
+		d := t * math.Sqrt(m.variance(0))
+		return m.mean - d, m.mean + d
+	case OUTMAD:
+		// modified z-score 0.6745 * |x - median| / MAD > t (Iglewicz and Hoaglin)
+		median := medianOfFloat64(values)
+		for i, v := range values {
+			values[i] = math.Abs(v - median)
+		}
+		d := t * medianOfFloat64(values) / 0.6745
+		return median - d, median + d
+	default:
+		// outside of [Q1 - t * IQR, Q3 + t * IQR]
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+		at := func(i int) realFloat64 { return values[i] }
+		q1, q3 := quantile(len(values), at, 0.25, QLINEAR), quantile(len(values), at, 0.75, QLINEAR)
+		return q1 - t*(q3-q1), q3 + t*(q3-q1)
+	}
+}
+
+// Outliers(method, [threshold]) returns an *IterableInt with the indices of the outliers (like Where) by method
+// OUTZSCORE: |z-score| > threshold (default ZSCORETHRESHOLD)
+// OUTMAD: modified z-score 0.6745 * |x - median| / MAD > threshold (default MADTHRESHOLD)
+// OUTIQR: outside of the fences Q1 - threshold * IQR and Q3 + threshold * IQR (default IQRFACTOR)
+// If all elements are equal (stddev, MAD or IQR of 0) every element different from the center is an outlier
+// Uses memory (copy of the elements to get the median or quartiles and the new []int slice)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Outliers(method int, threshold ...realFloat64) *IterableInt {
+	lo, hi := fencesFloat64(iter.List(), method, threshold)
+	return iter.Where(func(v float64) bool { return realFloat64(v) < lo || realFloat64(v) > hi })
+}
+
+// DropOutliers(method, [threshold]) returns a new iterable without the outliers (s. Outliers)
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) DropOutliers(method int, threshold ...realFloat64) *IterableFloat64 {
+	lo, hi := fencesFloat64(iter.List(), method, threshold)
+	newIter := make([]float64, 0, iter.Len)
+	for _, v := range iter.List() {
+		if !(realFloat64(v) < lo || realFloat64(v) > hi) {
+			newIter = append(newIter, v)
+		}
+	}
+	return ToIterFloat64(newIter)
+}
+
+// Winsorize(method, [threshold]) returns a new iterable with the outliers (s. Outliers) replaced by
+// the smallest or largest element that is not an outlier
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Winsorize(method int, threshold ...realFloat64) *IterableFloat64 {
+	s := iter.List()
+	lo, hi := fencesFloat64(s, method, threshold)
+	var min, max float64
+	found := false
+	for _, v := range s {
+		if realFloat64(v) < lo || realFloat64(v) > hi || math.IsNaN(realFloat64(v)) {
+			continue
+		}
+		if !found || v < min {
+			min = v
+		}
+		if !found || v > max {
+			max = v
+		}
+		found = true
+	}
+	newIter := make([]float64, len(s))
+	for i, v := range s {
+		switch {
+		case realFloat64(v) < lo:
+			newIter[i] = min
+		case realFloat64(v) > hi:
+			newIter[i] = max
+		default:
+			newIter[i] = v
+		}
+	}
+	return ToIterFloat64(newIter)
+}
@@ -0,0 +1,79 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Helpers of the Parallel* methods (s. parallelFloat64.go)
+// The elements are split into contiguous parts like Tee does, every part is handled by its own goroutine
+// and the results are merged in the order of the parts, so the results do not depend on the scheduling.
+
+// parallelWorkers returns the number of workers (default runtime.GOMAXPROCS(0)) for n elements
+func parallelWorkers(n int, workers []int) int {
+	w := runtime.GOMAXPROCS(0)
+	if len(workers) == 1 {
+		w = workers[0]
+	}
+	if w < 1 {
+		panic(ERR_WORKERS)
+	}
+	if w > n {
+		w = n
+	}
+	if w < 1 {
+		w = 1
+	}
+	return w
+}
+
+// parallelParts returns the bounds [lo, hi) of w contiguous parts of n elements (the last may be shorter)
+func parallelParts(n, w int) [][2]int {
+	interval := (n + w - 1) / w
+	if interval < 1 {
+		interval = 1
+	}
+	parts := make([][2]int, 0, w)
+	for lo := 0; lo < n; lo += interval {
+		hi := lo + interval
+		if hi > n {
+			hi = n
+		}
+		parts = append(parts, [2]int{lo, hi})
+	}
+	return parts
+}
+
+// parallelDo runs fn(p, lo, hi) for every part p in its own goroutine and waits for all to finish
+func parallelDo(parts [][2]int, fn func(p, lo, hi int)) {
+	wg := new(sync.WaitGroup)
+	wg.Add(len(parts))
+	for p, bounds := range parts {
+		go func(p, lo, hi int) {
+			defer wg.Done()
+			fn(p, lo, hi)
+		}(p, bounds[0], bounds[1])
+	}
+	wg.Wait()
+}
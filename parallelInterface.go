@@ -0,0 +1,226 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "sort"
+
+// Parallel map, filter, reduce, scan and sort of IterableIf
+// The elements are split into contiguous views over the underlying slice (like Tee) handled by workers goroutines
+// (default runtime.GOMAXPROCS(0)) and the results are merged in order, so they equal the serial Map, MapInto,
+// Filter, Reduce, Accumulate and a stable sort. The functions are called concurrently and must be safe for that.
+
+// ParallelMap(mapFn, [workers]) applies the mapFunction to all elements with workers goroutines and
+// returns a new iterator with the resulting values in the original order (like Map)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableIf) ParallelMap(fn func(interface{}) interface{}, workers ...int) *IterableIf {
+	s := iter.List()
+	newIter := make([]interface{}, len(s))
+	parallelDo(parallelParts(len(s), parallelWorkers(len(s), workers)), func(_, lo, hi int) {
+		for i, v := range s[lo:hi] {
+			newIter[lo+i] = fn(v)
+		}
+	})
+	return ToIterIf(newIter)
+}
+
+// ParallelMapInto(mapFn, [workers]) applies the mapFunction to all elements with workers goroutines
+// changing the underlying slice to the result and returns itself (like MapInto)
+// No additional memory needed
+func (iter *IterableIf) ParallelMapInto(fn func(interface{}) interface{}, workers ...int) *IterableIf {
+	s := iter.List()
+	parallelDo(parallelParts(len(s), parallelWorkers(len(s), workers)), func(_, lo, hi int) {
+		ToIterIf(s[lo:hi]).MapInto(fn)
+	})
+	return iter
+}
+
+// ParallelFilter(condition, [workers]) returns a new iterable with the elements meeting the condition
+// in the original order (like Filter), the parts are filtered by workers goroutines
+// Uses memory (new slices per part and the merged slice) and the new iterable refers to the merged slice
+// Does not change the underlying original slice
+func (iter *IterableIf) ParallelFilter(cond func(interface{}) bool, workers ...int) *IterableIf {
+	s := iter.List()
+	parts := parallelParts(len(s), parallelWorkers(len(s), workers))
+	results := make([][]interface{}, len(parts))
+	parallelDo(parts, func(p, lo, hi int) {
+		results[p] = ToIterIf(s[lo:hi]).Filter(cond).List()
+	})
+	n := 0
+	for _, r := range results {
+		n += len(r)
+	}
+	newIter := make([]interface{}, 0, n)
+	for _, r := range results {
+		newIter = append(newIter, r...)
+	}
+	return ToIterIf(newIter)
+}
+
+// ParallelReduce(reduceFn, [workers]) reduces the parts with workers goroutines and then the results
+// of the parts in their order; equals Reduce for an associative reduceFn like addition, min or max
+// Panics with ERR_SHORTER1 for an empty iterable
+// Uses memory (one result per part)
+// Does not change the underlying original slice
+func (iter *IterableIf) ParallelReduce(fn func(interface{}, interface{}) interface{}, workers ...int) interface{} {
+	s := iter.List()
+	if len(s) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	parts := parallelParts(len(s), parallelWorkers(len(s), workers))
+	results := make([]interface{}, len(parts))
+	parallelDo(parts, func(p, lo, hi int) {
+		results[p] = ToIterIf(s[lo:hi]).Reduce(fn)
+	})
+	state := results[0]
+	for _, r := range results[1:] {
+		state = fn(state, r)
+	}
+	return state
+}
+
+// scanIf writes the inclusive scan src[0], fn(src[0], src[1]) ... into dst
+func scanIf(dst, src []interface{}, fn func(interface{}, interface{}) interface{}) {
+	for i, v := range src {
+		if i == 0 {
+			dst[0] = v
+			continue
+		}
+		dst[i] = fn(dst[i-1], v)
+	}
+}
+
+// Accumulate(fn) returns a new iterable with the accumulated results fn(...fn(fn(s[0], s[1]), s[2])..., s[i])
+// of all elements up to i (inclusive prefix scan like Python's itertools.accumulate, running sums for addition)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableIf) Accumulate(fn func(interface{}, interface{}) interface{}) *IterableIf {
+	s := iter.List()
+	newIter := make([]interface{}, len(s))
+	scanIf(newIter, s, fn)
+	return ToIterIf(newIter)
+}
+
+// ParallelAccumulate(fn, [workers]) returns the same as Accumulate for an associative fn computed by workers
+// goroutines: the parts are scanned in parallel, then the totals of the parts serially and finally
+// the total of the preceding parts is applied to every part in parallel (about 2 * Len calls of fn)
+// Falls back to Accumulate below PARALLELTHRESHOLD elements
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableIf) ParallelAccumulate(fn func(interface{}, interface{}) interface{}, workers ...int) *IterableIf {
+	s := iter.List()
+	w := parallelWorkers(len(s), workers)
+	if len(s) < PARALLELTHRESHOLD || w == 1 {
+		return iter.Accumulate(fn)
+	}
+	newIter := make([]interface{}, len(s))
+	parts := parallelParts(len(s), w)
+	parallelDo(parts, func(_, lo, hi int) {
+		scanIf(newIter[lo:hi], s[lo:hi], fn)
+	})
+	// offsets[p] is the scan up to the end of part p-1
+	offsets := make([]interface{}, len(parts))
+	for p := 1; p < len(parts); p++ {
+		total := newIter[parts[p-1][1]-1]
+		if p == 1 {
+			offsets[p] = total
+			continue
+		}
+		offsets[p] = fn(offsets[p-1], total)
+	}
+	parallelDo(parts, func(p, lo, hi int) {
+		if p == 0 {
+			return
+		}
+		for i := lo; i < hi; i++ {
+			newIter[i] = fn(offsets[p], newIter[i])
+		}
+	})
+	return ToIterIf(newIter)
+}
+
+// mergeIf merges the sorted a and b into dst (len(a) + len(b)), a first for equal elements
+func mergeIf(dst, a, b []interface{}, less func(interface{}, interface{}) bool) {
+	i, j := 0, 0
+	for k := range dst {
+		if j >= len(b) || i < len(a) && !less(b[j], a[i]) {
+			dst[k] = a[i]
+			i++
+			continue
+		}
+		dst[k] = b[j]
+		j++
+	}
+}
+
+// ParallelSortInto(less, [workers]) sorts the elements stable by less with a parallel merge sort changing
+// the underlying slice and returns itself: the parts are sorted by workers goroutines and merged pairwise
+// in parallel rounds
+// Falls back to a serial stable sort below PARALLELTHRESHOLD elements
+// Uses memory (buffer with the originals dimensions)
+func (iter *IterableIf) ParallelSortInto(less func(interface{}, interface{}) bool, workers ...int) *IterableIf {
+	s := iter.List()
+	w := parallelWorkers(len(s), workers)
+	if len(s) < PARALLELTHRESHOLD || w == 1 {
+		sort.SliceStable(s, func(i, j int) bool { return less(s[i], s[j]) })
+		return iter
+	}
+	parts := parallelParts(len(s), w)
+	parallelDo(parts, func(_, lo, hi int) {
+		part := s[lo:hi]
+		sort.SliceStable(part, func(i, j int) bool { return less(part[i], part[j]) })
+	})
+	src, dst := s, make([]interface{}, len(s))
+	for len(parts) > 1 {
+		merged := make([][2]int, 0, (len(parts)+1)/2)
+		for p := 0; p < len(parts); p += 2 {
+			if p+1 < len(parts) {
+				merged = append(merged, [2]int{parts[p][0], parts[p+1][1]})
+				continue
+			}
+			merged = append(merged, parts[p])
+		}
+		parallelDo(merged, func(p, lo, hi int) {
+			if 2*p+1 >= len(parts) {
+				copy(dst[lo:hi], src[lo:hi])
+				return
+			}
+			mid := parts[2*p][1]
+			mergeIf(dst[lo:hi], src[lo:mid], src[mid:hi], less)
+		})
+		parts = merged
+		src, dst = dst, src
+	}
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+	return iter
+}
+
+// ParallelSort(less, [workers]) returns a new iterable with the elements sorted stable by less (s. ParallelSortInto)
+// Uses memory (new slice and buffer with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableIf) ParallelSort(less func(interface{}, interface{}) bool, workers ...int) *IterableIf {
+	newIter := make([]interface{}, iter.Len)
+	copy(newIter, iter.List())
+	return ToIterIf(newIter).ParallelSortInto(less, workers...)
+}
@@ -0,0 +1,237 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "math"
+
+// Returns analysis of IterableFloat64 (prices or values over time)
+// The methods work on the underlying slice and change neither the slice nor the iterable's index.
+// Every series has an eager variant returning a new iterable and a stepwise *Next variant
+// returning the next value and a bool indicator for the exhaustion.
+
+// collectFloat64 collects n values of the stepwise function in a new iterable
+func collectFloat64(step func() (float64, bool), n int) *IterableFloat64 {
+	newIter := make([]float64, n)
+	for i := range newIter {
+		newIter[i], _ = step()
+	}
+	return ToIterFloat64(newIter)
+}
+
+// PctChangeNext([periods=1]) returns stepwise the relative change (s[i] - s[i-periods]) / s[i-periods]
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) PctChangeNext(periods ...int) func() (float64, bool) {
+	p := 1
+	if len(periods) == 1 {
+		p = periods[0]
+	}
+	if p < 1 || p > iter.Len {
+		panic(ERR_WINDOW)
+	}
+	s := iter.List()
+	i := p
+	return func() (float64, bool) {
+		if i >= len(s) {
+			return MINFLOAT64, true
+		}
+		prev := realFloat64(s[i-p])
+		i++
+		return float64((realFloat64(s[i-1]) - prev) / prev), false
+	}
+}
+
+// PctChange([periods=1]) returns a new iterable (len - periods) with the relative changes
+// (s[i] - s[i-periods]) / s[i-periods] like pandas pct_change without the leading NaN
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) PctChange(periods ...int) *IterableFloat64 {
+	step := iter.PctChangeNext(periods...)
+	p := 1
+	if len(periods) == 1 {
+		p = periods[0]
+	}
+	return collectFloat64(step, iter.Len-p)
+}
+
+// LogReturnsNext() returns stepwise the log return ln(s[i] / s[i-1])
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) LogReturnsNext() func() (float64, bool) {
+	s := iter.List()
+	i := 1
+	return func() (float64, bool) {
+		if i >= len(s) {
+			return MINFLOAT64, true
+		}
+		i++
+		return float64(math.Log(realFloat64(s[i-1]) / realFloat64(s[i-2]))), false
+	}
+}
+
+// LogReturns() returns a new iterable (len - 1) with the log returns ln(s[i] / s[i-1])
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) LogReturns() *IterableFloat64 {
+	if iter.Len < 1 {
+		return ToIterFloat64([]float64{})
+	}
+	return collectFloat64(iter.LogReturnsNext(), iter.Len-1)
+}
+
+// CumProdNext() returns stepwise the cumulative product s[0] * ... * s[i] (accumulated in realFloat64)
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) CumProdNext() func() (float64, bool) {
+	s := iter.List()
+	i := 0
+	var prod realFloat64 = 1
+	return func() (float64, bool) {
+		if i >= len(s) {
+			return MINFLOAT64, true
+		}
+		prod *= realFloat64(s[i])
+		i++
+		return float64(prod), false
+	}
+}
+
+// CumProd() returns a new iterable with the cumulative products s[0] * ... * s[i]
+// i.e. the growth of 1 by the factors 1 + return
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) CumProd() *IterableFloat64 {
+	return collectFloat64(iter.CumProdNext(), iter.Len)
+}
+
+// CumMaxNext() returns stepwise the running maximum of s[0] ... s[i]
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) CumMaxNext() func() (float64, bool) {
+	s := iter.List()
+	i := 0
+	var max float64
+	return func() (float64, bool) {
+		if i >= len(s) {
+			return MINFLOAT64, true
+		}
+		if i == 0 || s[i] > max {
+			max = s[i]
+		}
+		i++
+		return max, false
+	}
+}
+
+// CumMax() returns a new iterable with the running maximum of s[0] ... s[i] (the peaks)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) CumMax() *IterableFloat64 {
+	return collectFloat64(iter.CumMaxNext(), iter.Len)
+}
+
+// DrawdownNext() returns stepwise the drawdown s[i] / peak - 1 (0 or negative) from the running maximum
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) DrawdownNext() func() (float64, bool) {
+	s := iter.List()
+	peaks := iter.CumMaxNext()
+	i := 0
+	return func() (float64, bool) {
+		peak, exhausted := peaks()
+		if exhausted {
+			return MINFLOAT64, true
+		}
+		i++
+		return float64(realFloat64(s[i-1])/realFloat64(peak) - 1), false
+	}
+}
+
+// Drawdown() returns a new iterable with the drawdowns s[i] / peak - 1 (0 or negative) of the (positive)
+// values from their running maximum
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Drawdown() *IterableFloat64 {
+	return collectFloat64(iter.DrawdownNext(), iter.Len)
+}
+
+// MaxDrawdown() returns the largest drawdown 1 - trough / peak (0 or positive) of the (positive) values
+// with the indices of its peak (start) and trough (end); 0, 0, 0 for a series that never falls
+// Does not change the underlying original slice
+func (iter *IterableFloat64) MaxDrawdown() (maxDD realFloat64, start, end int) {
+	s := iter.List()
+	peak := 0
+	for i, v := range s {
+		if v > s[peak] {
+			peak = i
+			continue
+		}
+		if dd := 1 - realFloat64(v)/realFloat64(s[peak]); dd > maxDD {
+			maxDD, start, end = dd, peak, i
+		}
+	}
+	return maxDD, start, end
+}
+
+// excessMomentsFloat64 returns the moments of the returns in excess of riskFree (per period)
+func excessMomentsFloat64(s []float64, riskFree realFloat64) moments {
+	if len(s) < 2 {
+		panic(ERR_SHORTER2)
+	}
+	var m moments
+	for _, v := range s {
+		m.add(realFloat64(v) - riskFree)
+	}
+	return m
+}
+
+// annualizeFloat64 returns the factor sqrt(periodsPerYear) (1 if not given)
+func annualizeFloat64(periodsPerYear []int) realFloat64 {
+	if len(periodsPerYear) == 1 {
+		return math.Sqrt(realFloat64(periodsPerYear[0]))
+	}
+	return 1
+}
+
+// SharpeRatio(riskFree, [periodsPerYear]) returns the Sharpe ratio of the returns: the mean of the excess returns
+// r - riskFree over their sample standard deviation, annualized by sqrt(periodsPerYear) (i.e. 252 for daily returns)
+// riskFree is the risk free return per period
+// Does not change the underlying original slice
+func (iter *IterableFloat64) SharpeRatio(riskFree realFloat64, periodsPerYear ...int) realFloat64 {
+	m := excessMomentsFloat64(iter.List(), riskFree)
+	return m.mean / math.Sqrt(m.variance(1)) * annualizeFloat64(periodsPerYear)
+}
+
+// SortinoRatio(riskFree, [periodsPerYear]) returns the Sortino ratio of the returns: the mean of the excess returns
+// r - riskFree over their downside deviation sqrt(mean(min(0, r - riskFree)²)), annualized like SharpeRatio
+// Does not change the underlying original slice
+func (iter *IterableFloat64) SortinoRatio(riskFree realFloat64, periodsPerYear ...int) realFloat64 {
+	s := iter.List()
+	m := excessMomentsFloat64(s, riskFree)
+	var downside realFloat64
+	for _, v := range s {
+		if d := realFloat64(v) - riskFree; d < 0 {
+			downside += d * d
+		}
+	}
+	return m.mean / math.Sqrt(downside/realFloat64(len(s))) * annualizeFloat64(periodsPerYear)
+}
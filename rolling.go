@@ -0,0 +1,135 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "math"
+
+// kinds of rolling statistics (s. rollingNext<T> in rollingFloat64.go)
+const (
+	rollSUM = iota
+	rollMEAN
+	rollSTD
+	rollMIN
+	rollMAX
+)
+
+// windowMoments holds sum, mean and squared deviations of the finite elements in a sliding window
+// and counts the NaN and infinite ones
+// The sum is compensated (Neumaier) and the moments are updated like Welford's algorithm
+// when an element enters and leaves the window, so the rounding errors do not add up over the series.
+// Non-finite elements never enter the moments (NaN - NaN and Inf - Inf are NaN and would spoil every later
+// window), the statistic is NaN or ±Inf only while they are in the window.
+type windowMoments struct {
+	n, sum, comp, mean, m2 float64
+	nan, posInf, negInf    int
+}
+
+// addSum adds x to the compensated sum
+func (w *windowMoments) addSum(x float64) {
+	t := w.sum + x
+	if math.Abs(w.sum) >= math.Abs(x) {
+		w.comp += (w.sum - t) + x
+	} else {
+		w.comp += (x - t) + w.sum
+	}
+	w.sum = t
+}
+
+// count adds d to the counter of the non-finite x and reports if x is non-finite
+func (w *windowMoments) count(x float64, d int) bool {
+	switch {
+	case math.IsNaN(x):
+		w.nan += d
+	case math.IsInf(x, 1):
+		w.posInf += d
+	case math.IsInf(x, -1):
+		w.negInf += d
+	default:
+		return false
+	}
+	return true
+}
+
+// push adds x entering the window
+func (w *windowMoments) push(x float64) {
+	if w.count(x, 1) {
+		return
+	}
+	w.addSum(x)
+	w.n++
+	d := x - w.mean
+	w.mean += d / w.n
+	w.m2 += d * (x - w.mean)
+}
+
+// pop removes x leaving the window
+func (w *windowMoments) pop(x float64) {
+	if w.count(x, -1) {
+		return
+	}
+	w.addSum(-x)
+	w.n--
+	if w.n == 0 {
+		w.sum, w.comp, w.mean, w.m2 = 0, 0, 0, 0
+		return
+	}
+	d := x - w.mean
+	w.mean -= d / w.n
+	w.m2 -= d * (x - w.mean)
+	if w.m2 < 0 {
+		w.m2 = 0
+	}
+}
+
+// stat returns the statistic kind (rollSUM, rollMEAN or rollSTD) of the window
+// NaN if there is a NaN or both infinities in the window, ±Inf for sum and mean (NaN for std) with an infinity
+func (w *windowMoments) stat(kind int) float64 {
+	if w.nan > 0 || w.posInf > 0 && w.negInf > 0 || kind == rollSTD && w.posInf+w.negInf > 0 {
+		return math.NaN()
+	}
+	switch {
+	case w.posInf > 0:
+		return math.Inf(1)
+	case w.negInf > 0:
+		return math.Inf(-1)
+	}
+	switch kind {
+	case rollSUM:
+		return w.sum + w.comp
+	case rollMEAN:
+		return (w.sum + w.comp) / w.n
+	}
+	// sample standard deviation
+	if w.n < 2 {
+		return math.NaN()
+	}
+	return math.Sqrt(w.m2 / (w.n - 1))
+}
+
+// SpanAlpha(span) returns the smoothing factor alpha = 2 / (span + 1) of an EWMA
+// spanning span elements (like pandas ewm(span=span)) to be used with EWMA(alpha)
+func SpanAlpha(span float64) float64 {
+	if span < 1 {
+		panic(ERR_SPAN)
+	}
+	return 2 / (span + 1)
+}
@@ -0,0 +1,204 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "math"
+
+// Rolling statistics of IterableFloat64
+// The methods work on the underlying slice and change neither the slice nor the iterable's index.
+// The Rolling* methods return the statistic of every full window of size window,
+// the results have the length Len - window + 1 (the first result is the window over s[0:window]).
+
+// rollingNextFloat64 returns a stepwise function yielding the statistic kind over the sliding windows of s
+// and a bool indicator for the exhaustion (all windows done)
+// Min and max are taken from a monotonic deque of indices, so every window costs O(1) amortized;
+// NaN stays out of the deque and the result is NaN while the last NaN is in the window
+func rollingNextFloat64(s []float64, window, kind int) func() (float64, bool) {
+	if window < 1 || window > len(s) {
+		panic(ERR_WINDOW)
+	}
+	var (
+		w       windowMoments
+		deque   = make([]int, 0, window)
+		lastNaN = -window
+	)
+	// slide lets s[i] enter and s[i-window] leave the window
+	slide := func(i int) {
+		if kind < rollMIN {
+			if old := i - window; old >= 0 {
+				w.pop(realFloat64(s[old]))
+			}
+			w.push(realFloat64(s[i]))
+			return
+		}
+		if len(deque) > 0 && deque[0] <= i-window {
+			deque = deque[1:]
+		}
+		if math.IsNaN(realFloat64(s[i])) {
+			lastNaN = i
+			return
+		}
+		for len(deque) > 0 && (kind == rollMIN && s[deque[len(deque)-1]] >= s[i] ||
+			kind == rollMAX && s[deque[len(deque)-1]] <= s[i]) {
+			deque = deque[:len(deque)-1]
+		}
+		deque = append(deque, i)
+	}
+	next := 0
+	for ; next < window-1; next++ {
+		slide(next)
+	}
+	return func() (float64, bool) {
+		if next >= len(s) {
+			return MINFLOAT64, true
+		}
+		slide(next)
+		next++
+		if kind < rollMIN {
+			return float64(w.stat(kind)), false
+		}
+		if lastNaN > next-1-window {
+			return float64(math.NaN()), false
+		}
+		return s[deque[0]], false
+	}
+}
+
+// rollingFloat64 collects the results of rollingNextFloat64 in a new iterable
+func rollingFloat64(s []float64, window, kind int) *IterableFloat64 {
+	step := rollingNextFloat64(s, window, kind)
+	newIter := make([]float64, len(s)-window+1)
+	for i := range newIter {
+		newIter[i], _ = step()
+	}
+	return ToIterFloat64(newIter)
+}
+
+// RollingSum(window) returns a new iterable (len - window + 1) with the (compensated) sums of the sliding windows
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingSum(window int) *IterableFloat64 {
+	return rollingFloat64(iter.List(), window, rollSUM)
+}
+
+// RollingSumNext(window) returns stepwise the sum of the next sliding window
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingSumNext(window int) func() (float64, bool) {
+	return rollingNextFloat64(iter.List(), window, rollSUM)
+}
+
+// RollingMean(window) returns a new iterable (len - window + 1) with the means of the sliding windows (moving average)
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingMean(window int) *IterableFloat64 {
+	return rollingFloat64(iter.List(), window, rollMEAN)
+}
+
+// RollingMeanNext(window) returns stepwise the mean of the next sliding window
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingMeanNext(window int) func() (float64, bool) {
+	return rollingNextFloat64(iter.List(), window, rollMEAN)
+}
+
+// RollingStd(window) returns a new iterable (len - window + 1) with the sample standard deviations
+// of the sliding windows (NaN for window 1)
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingStd(window int) *IterableFloat64 {
+	return rollingFloat64(iter.List(), window, rollSTD)
+}
+
+// RollingStdNext(window) returns stepwise the sample standard deviation of the next sliding window
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingStdNext(window int) func() (float64, bool) {
+	return rollingNextFloat64(iter.List(), window, rollSTD)
+}
+
+// RollingMin(window) returns a new iterable (len - window + 1) with the minimum of the sliding windows
+// O(len) using a monotonic deque
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingMin(window int) *IterableFloat64 {
+	return rollingFloat64(iter.List(), window, rollMIN)
+}
+
+// RollingMinNext(window) returns stepwise the minimum of the next sliding window
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingMinNext(window int) func() (float64, bool) {
+	return rollingNextFloat64(iter.List(), window, rollMIN)
+}
+
+// RollingMax(window) returns a new iterable (len - window + 1) with the maximum of the sliding windows
+// O(len) using a monotonic deque
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingMax(window int) *IterableFloat64 {
+	return rollingFloat64(iter.List(), window, rollMAX)
+}
+
+// RollingMaxNext(window) returns stepwise the maximum of the next sliding window
+// and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) RollingMaxNext(window int) func() (float64, bool) {
+	return rollingNextFloat64(iter.List(), window, rollMAX)
+}
+
+// EWMANext(alpha) returns stepwise the exponentially weighted moving average
+// y[0] = x[0], y[i] = (1 - alpha) * y[i-1] + alpha * x[i] (like pandas ewm(alpha=alpha, adjust=False).mean())
+// and a bool indicator for the exhaustion of the iterable; use SpanAlpha(span) to smooth over a span
+// Does not change the underlying original slice
+func (iter *IterableFloat64) EWMANext(alpha realFloat64) func() (float64, bool) {
+	if alpha <= 0 || alpha > 1 {
+		panic(ERR_ALPHA)
+	}
+	s := iter.List()
+	var y realFloat64
+	next := 0
+	return func() (float64, bool) {
+		if next >= len(s) {
+			return MINFLOAT64, true
+		}
+		if next == 0 {
+			y = realFloat64(s[0])
+		} else {
+			y += alpha * (realFloat64(s[next]) - y)
+		}
+		next++
+		return float64(y), false
+	}
+}
+
+// EWMA(alpha) returns a new iterable (len) with the exponentially weighted moving average (s. EWMANext)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) EWMA(alpha realFloat64) *IterableFloat64 {
+	step := iter.EWMANext(alpha)
+	newIter := make([]float64, iter.Len)
+	for i := range newIter {
+		newIter[i], _ = step()
+	}
+	return ToIterFloat64(newIter)
+}
@@ -24,4 +24,12 @@ func main() {
 	fmt.Println(f1f2.List())
 	// Output: [0 1 4 9 16 25 36 49 64 81]
 
+	// derivatives of f1(x) = x*x (dx = 1) without zipping
+	fmt.Println(f1seq.Diff().List())
+	// Output: [1 3 5 7 9 11 13 15 17]
+	fmt.Println(f1seq.Gradient(1).List())
+	// Output: [1 2 4 6 8 10 12 14 16 17]
+	fmt.Println(f1seq.Diff(2).List())
+	// Output: [2 2 2 2 2 2 2 2]
+
 }
@@ -30,7 +30,9 @@ func main() {
 	}
 
 	// map - filter - reduce with 4 goroutines
+	// the goroutines add their part to erg, so the mutex guards it
 	wg := new(sync.WaitGroup)
+	mu := new(sync.Mutex)
 	tms := make([]float64, 100)
 	erg := 0
 	for i := 0; i < 100; i++ {
@@ -50,7 +52,10 @@ func main() {
 			wg.Add(1)
 			go func(chr chan *iter.IterableInt) {
 				ze := <-chr
-				erg += ze.Reduce(func(x, y int) int { return x + y })
+				part := ze.Reduce(func(x, y int) int { return x + y })
+				mu.Lock()
+				erg += part
+				mu.Unlock()
 				wg.Done()
 			}(chR)
 		}
@@ -83,4 +88,23 @@ func main() {
 		"\nresult:", erg,
 		"\ntiming (100 rep):", iter.ToIterFloat64(tms).Reduce(func(x, y float64) float64 { return x + y })/100, "ns/op length", SAMPLELEN)
 
+	// map - filter - reduce with the Parallel methods and 4 workers
+	tms = make([]float64, 100)
+	erg = 0
+	for i := 0; i < 100; i++ {
+		seq := iter.ToIterInt(l1)
+		st := time.Now()
+		erg = seq.ParallelMapInto(
+			mapFn, 4,
+		).ParallelFilter(
+			func(i int) bool { return i&1 == 0 }, 4,
+		).ParallelReduce(
+			func(x, y int) int { return x + y }, 4)
+		tms[i] = float64(time.Since(st))
+		copy(l1, l2) // revert changes
+	}
+	fmt.Println("map - filter - reduce with ParallelMapInto, ParallelFilter and ParallelReduce",
+		"\nresult:", erg,
+		"\ntiming (100 rep):", iter.ToIterFloat64(tms).Reduce(func(x, y float64) float64 { return x + y })/100, "ns/op length", SAMPLELEN)
+
 }
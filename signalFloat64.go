@@ -0,0 +1,142 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+// Signal processing of IterableFloat64
+// The methods work on the underlying slices and change neither the slices nor the iterables' index.
+
+// convolveFloat64 returns the full discrete convolution of a and v (len(a) + len(v) - 1) cut to mode
+// reverse correlates instead (v is applied back to front)
+func convolveFloat64(a, v []float64, mode int, reverse bool) []float64 {
+	if len(a) < 1 || len(v) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	full := make([]float64, len(a)+len(v)-1)
+	last := len(v) - 1
+	for i, x := range a {
+		for j, k := range v {
+			if reverse {
+				k = v[last-j]
+			}
+			full[i+j] += x * k
+		}
+	}
+	long, short := len(a), len(v)
+	if short > long {
+		long, short = short, long
+	}
+	switch mode {
+	case CONVFULL:
+		return full
+	case CONVSAME:
+		start := (short - 1) / 2
+		return append(make([]float64, 0, long), full[start:start+long]...)
+	case CONVVALID:
+		return append(make([]float64, 0, long-short+1), full[short-1:long]...)
+	}
+	panic(ERR_METHOD)
+}
+
+// Convolve(kernel, [mode=CONVFULL]) returns a new iterable with the discrete linear convolution of the iterable
+// and kernel (like numpy.convolve); mode CONVFULL (len + kernel.Len - 1), CONVSAME (max(len, kernel.Len), centered)
+// or CONVVALID (only where both overlap completely, max - min + 1)
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Convolve(kernel *IterableFloat64, mode ...int) *IterableFloat64 {
+	m := CONVFULL
+	if len(mode) == 1 {
+		m = mode[0]
+	}
+	return ToIterFloat64(convolveFloat64(iter.List(), kernel.List(), m, false))
+}
+
+// Correlate(v, [mode=CONVVALID]) returns a new iterable with the cross-correlation c[k] = sum(iter[n+k] * v[n])
+// of the iterable and v (like numpy.correlate, which defaults to CONVVALID, too); s. Convolve for the modes
+// Uses memory (new slice) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Correlate(v *IterableFloat64, mode ...int) *IterableFloat64 {
+	m := CONVVALID
+	if len(mode) == 1 {
+		m = mode[0]
+	}
+	return ToIterFloat64(convolveFloat64(iter.List(), v.List(), m, true))
+}
+
+// FIRFilterFloat64(taps) returns a streaming FIR filter: every call with the next input sample x[n] returns
+// y[n] = taps[0]*x[n] + taps[1]*x[n-1] + ... (the samples before the first one are 0 like scipy.signal.lfilter)
+// The filter keeps the last len(taps) samples in a ring buffer; taps is copied
+func FIRFilterFloat64(taps []float64) func(float64) float64 {
+	if len(taps) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	b := append(make([]float64, 0, len(taps)), taps...)
+	ring := make([]float64, len(b))
+	pos := 0 // index of the latest sample in ring
+	return func(x float64) float64 {
+		pos--
+		if pos < 0 {
+			pos = len(ring) - 1
+		}
+		ring[pos] = x
+		var y realFloat64
+		// ring[pos:] holds x[n], x[n-1] ..., ring[:pos] the older samples
+		k := 0
+		for _, v := range ring[pos:] {
+			y += realFloat64(b[k]) * realFloat64(v)
+			k++
+		}
+		for _, v := range ring[:pos] {
+			y += realFloat64(b[k]) * realFloat64(v)
+			k++
+		}
+		return float64(y)
+	}
+}
+
+// FIRNext(taps) returns stepwise the next output sample of the FIR filter with the coefficients taps
+// (s. FIRFilterFloat64) over the iterable and a bool indicator for the exhaustion of the iterable
+// Does not change the underlying original slice
+func (iter *IterableFloat64) FIRNext(taps *IterableFloat64) func() (float64, bool) {
+	filter := FIRFilterFloat64(taps.List())
+	s := iter.List()
+	next := 0
+	return func() (float64, bool) {
+		if next >= len(s) {
+			return MINFLOAT64, true
+		}
+		next++
+		return filter(s[next-1]), false
+	}
+}
+
+// FIR(taps) returns a new iterable (len) with the output of the FIR filter with the coefficients taps
+// (s. FIRFilterFloat64); equals the first len elements of Convolve(taps)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) FIR(taps *IterableFloat64) *IterableFloat64 {
+	step := iter.FIRNext(taps)
+	newIter := make([]float64, iter.Len)
+	for i := range newIter {
+		newIter[i], _ = step()
+	}
+	return ToIterFloat64(newIter)
+}
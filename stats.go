@@ -0,0 +1,175 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// errors returned by the functions over two iterables instead of panicking
+var (
+	ErrDiffLen   = errors.New(ERR_DIFFLEN)
+	ErrShorter2  = errors.New(ERR_SHORTER2)
+	ErrNotSorted = errors.New(ERR_NOTSORTED)
+)
+
+// Description is the result of the Describe method of the numeric iterables
+// Variance and StdDev are the sample values (n-1), Skewness and Kurtosis (excess) are computed
+// from the population moments like scipy.stats.skew and scipy.stats.kurtosis do
+type Description struct {
+	Count                  int
+	Mean, Variance, StdDev float64
+	Min, Q25, Median, Q75  float64
+	Max                    float64
+	Skewness, Kurtosis     float64
+}
+
+// moments holds the running central moments of Welford's online algorithm (extended by Terriberry)
+type moments struct {
+	n, mean, m2, m3, m4 float64
+}
+
+// add updates the moments with the next value x
+func (m *moments) add(x float64) {
+	n1 := m.n
+	m.n++
+	delta := x - m.mean
+	deltaN := delta / m.n
+	deltaN2 := deltaN * deltaN
+	term1 := delta * deltaN * n1
+	m.mean += deltaN
+	m.m4 += term1*deltaN2*(m.n*m.n-3*m.n+3) + 6*deltaN2*m.m2 - 4*deltaN*m.m3
+	m.m3 += term1*deltaN*(m.n-2) - 3*deltaN*m.m2
+	m.m2 += term1
+}
+
+// variance returns the variance with ddof delta degrees of freedom (NaN if n <= ddof)
+func (m *moments) variance(ddof int) float64 {
+	if m.n <= float64(ddof) {
+		return math.NaN()
+	}
+	return m.m2 / (m.n - float64(ddof))
+}
+
+// skewness returns the (biased) sample skewness g1
+func (m *moments) skewness() float64 {
+	return math.Sqrt(m.n) * m.m3 / math.Pow(m.m2, 1.5)
+}
+
+// kurtosis returns the (biased) sample excess kurtosis g2
+func (m *moments) kurtosis() float64 {
+	return m.n*m.m4/(m.m2*m.m2) - 3
+}
+
+// comoments holds the running means, squared deviations and co-deviation of two series (Welford)
+type comoments struct {
+	n, meanX, meanY, m2X, m2Y, cXY float64
+}
+
+// add updates the comoments with the next pair x, y
+func (c *comoments) add(x, y float64) {
+	c.n++
+	dx := x - c.meanX
+	c.meanX += dx / c.n
+	dy := y - c.meanY
+	c.meanY += dy / c.n
+	c.m2X += dx * (x - c.meanX)
+	c.m2Y += dy * (y - c.meanY)
+	c.cXY += dx * (y - c.meanY)
+}
+
+// pearson returns the Pearson correlation coefficient r
+func (c *comoments) pearson() float64 {
+	return c.cXY / math.Sqrt(c.m2X*c.m2Y)
+}
+
+// lessNaNLast orders ascending with NaN behind all numbers (like numpy.sort), so a sort with it
+// is consistent even if there are NaN
+func lessNaNLast(a, b float64) bool {
+	return a < b || math.IsNaN(b) && !math.IsNaN(a)
+}
+
+// ranks returns the ranks (1..n) of n values (at(i) returns the i-th), ties get the rank by method
+// (RANKAVERAGE, RANKMIN, RANKMAX, RANKDENSE or RANKORDINAL); NaN values get the rank NaN
+func ranks(n int, at func(int) float64, method int) []float64 {
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return lessNaNLast(at(idx[i]), at(idx[j])) })
+	r := make([]float64, n)
+	for n > 0 && math.IsNaN(at(idx[n-1])) {
+		n--
+		r[idx[n]] = math.NaN()
+	}
+	dense := 0.0
+	for i := 0; i < n; {
+		j := i + 1
+		for j < n && at(idx[j]) == at(idx[i]) {
+			j++
+		}
+		// elements i..j-1 are tied
+		dense++
+		for k := i; k < j; k++ {
+			switch method {
+			case RANKAVERAGE:
+				r[idx[k]] = float64(i+j+1) / 2
+			case RANKMIN:
+				r[idx[k]] = float64(i + 1)
+			case RANKMAX:
+				r[idx[k]] = float64(j)
+			case RANKDENSE:
+				r[idx[k]] = dense
+			case RANKORDINAL:
+				r[idx[k]] = float64(k + 1)
+			default:
+				panic(ERR_METHOD)
+			}
+		}
+		i = j
+	}
+	return r
+}
+
+// quantile returns the q-quantile of n > 0 sorted values (at(i) returns the i-th) interpolated by method
+func quantile(n int, at func(int) float64, q float64, method int) float64 {
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		panic(ERR_QUANTILE)
+	}
+	h := float64(n-1) * q
+	lo, hi := int(math.Floor(h)), int(math.Ceil(h))
+	switch method {
+	case QLINEAR:
+		return at(lo) + (h-float64(lo))*(at(hi)-at(lo))
+	case QLOWER:
+		return at(lo)
+	case QHIGHER:
+		return at(hi)
+	case QNEAREST:
+		return at(int(math.RoundToEven(h)))
+	case QMIDPOINT:
+		return (at(lo) + at(hi)) / 2
+	}
+	panic(ERR_METHOD)
+}
@@ -0,0 +1,216 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"math"
+	"sort"
+)
+
+// Statistics of IterableFloat64
+// The methods work on the underlying slice and change neither the slice nor the iterable's index.
+// NaN propagates: the moments and the order statistics (Quantile, Median, min and quartiles of Describe)
+// are NaN if there is a NaN (the float types have NanMedian and DropNaN to leave NaN out).
+
+// sortedFloat64 returns a sorted copy of s with NaN behind all numbers
+func sortedFloat64(s []float64) []float64 {
+	sorted := make([]float64, len(s))
+	copy(sorted, s)
+	sort.Slice(sorted, func(i, j int) bool { return lessNaNLast(realFloat64(sorted[i]), realFloat64(sorted[j])) })
+	return sorted
+}
+
+// momentsFloat64 runs Welford's online algorithm over s
+func momentsFloat64(s []float64) moments {
+	var m moments
+	for _, v := range s {
+		m.add(realFloat64(v))
+	}
+	return m
+}
+
+// Describe() returns the Description (count, mean, variance, stddev, min, quartiles, max, skewness, kurtosis)
+// The moments are computed in one pass with Welford's online algorithm, the quartiles (QLINEAR) need a sorted copy
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Describe() Description {
+	s := iter.List()
+	if len(s) < 1 {
+		panic(ERR_SHORTER1)
+	}
+	m := momentsFloat64(s)
+	sorted := sortedFloat64(s)
+	at := func(i int) realFloat64 { return realFloat64(sorted[i]) }
+	if math.IsNaN(at(len(s) - 1)) {
+		at = func(int) realFloat64 { return math.NaN() }
+	}
+	return Description{
+		Count:    len(s),
+		Mean:     m.mean,
+		Variance: m.variance(1),
+		StdDev:   math.Sqrt(m.variance(1)),
+		Min:      at(0),
+		Q25:      quantile(len(s), at, 0.25, QLINEAR),
+		Median:   quantile(len(s), at, 0.5, QLINEAR),
+		Q75:      quantile(len(s), at, 0.75, QLINEAR),
+		Max:      at(len(s) - 1),
+		Skewness: m.skewness(),
+		Kurtosis: m.kurtosis(),
+	}
+}
+
+// Variance([ddof=1]) returns the variance with ddof delta degrees of freedom (like numpy.var)
+// Default is the sample variance (ddof=1), use Variance(0) for the population variance
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Variance(ddof ...int) realFloat64 {
+	d := 1
+	if len(ddof) == 1 {
+		d = ddof[0]
+	}
+	m := momentsFloat64(iter.List())
+	return m.variance(d)
+}
+
+// StdDev([ddof=1]) returns the standard deviation with ddof delta degrees of freedom (s. Variance)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) StdDev(ddof ...int) realFloat64 {
+	return math.Sqrt(iter.Variance(ddof...))
+}
+
+// Skewness() returns the (biased) skewness g1 of the elements (like scipy.stats.skew)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Skewness() realFloat64 {
+	m := momentsFloat64(iter.List())
+	return m.skewness()
+}
+
+// Kurtosis() returns the (biased) excess kurtosis g2 of the elements (like scipy.stats.kurtosis)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Kurtosis() realFloat64 {
+	m := momentsFloat64(iter.List())
+	return m.kurtosis()
+}
+
+// Quantile(q, [method=QLINEAR]) returns the q-quantile (0 <= q <= 1) of the elements
+// method selects the interpolation between two elements like numpy.quantile does:
+// QLINEAR, QLOWER, QHIGHER, QNEAREST or QMIDPOINT; NaN if there is a NaN
+// Uses memory for a sorted copy of the underlying slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Quantile(q realFloat64, method ...int) realFloat64 {
+	if iter.Len < 1 {
+		panic(ERR_SHORTER1)
+	}
+	m := QLINEAR
+	if len(method) == 1 {
+		m = method[0]
+	}
+	sorted := sortedFloat64(iter.List())
+	if math.IsNaN(realFloat64(sorted[len(sorted)-1])) {
+		return math.NaN()
+	}
+	return quantile(len(sorted), func(i int) realFloat64 { return realFloat64(sorted[i]) }, q, m)
+}
+
+// Median() returns the median of the elements (Quantile(0.5))
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Median() realFloat64 {
+	return iter.Quantile(0.5)
+}
+
+// comomentsFloat64 runs Welford's online algorithm over the pairs of x and y
+func comomentsFloat64(x, y *IterableFloat64) (comoments, error) {
+	var c comoments
+	if x.Len != y.Len {
+		return c, ErrDiffLen
+	}
+	if x.Len < 2 {
+		return c, ErrShorter2
+	}
+	ys := y.List()
+	for i, v := range x.List() {
+		c.add(realFloat64(v), realFloat64(ys[i]))
+	}
+	return c, nil
+}
+
+// CovarianceFloat64(x, y, [ddof=1]) returns the covariance of two same length iterables
+// with ddof delta degrees of freedom (default 1 = sample covariance like Variance)
+// Returns ErrDiffLen if the iterables differ in length and ErrShorter2 if they have less than 2 elements
+// Does not change the underlying original slices
+func CovarianceFloat64(x, y *IterableFloat64, ddof ...int) (realFloat64, error) {
+	c, err := comomentsFloat64(x, y)
+	if err != nil {
+		return math.NaN(), err
+	}
+	d := 1
+	if len(ddof) == 1 {
+		d = ddof[0]
+	}
+	return c.cXY / (c.n - realFloat64(d)), nil
+}
+
+// PearsonFloat64(x, y) returns the Pearson correlation coefficient of two same length iterables
+// Returns ErrDiffLen if the iterables differ in length and ErrShorter2 if they have less than 2 elements
+// Does not change the underlying original slices
+func PearsonFloat64(x, y *IterableFloat64) (realFloat64, error) {
+	c, err := comomentsFloat64(x, y)
+	if err != nil {
+		return math.NaN(), err
+	}
+	return c.pearson(), nil
+}
+
+// SpearmanFloat64(x, y) returns the Spearman rank correlation coefficient of two same length iterables
+// (the Pearson correlation of the ranks, ties get their average rank)
+// Uses memory for the ranks of both iterables
+// Returns ErrDiffLen if the iterables differ in length and ErrShorter2 if they have less than 2 elements
+// Does not change the underlying original slices
+func SpearmanFloat64(x, y *IterableFloat64) (realFloat64, error) {
+	if x.Len != y.Len {
+		return math.NaN(), ErrDiffLen
+	}
+	if x.Len < 2 {
+		return math.NaN(), ErrShorter2
+	}
+	xs, ys := x.List(), y.List()
+	rx := ranks(len(xs), func(i int) realFloat64 { return realFloat64(xs[i]) }, RANKAVERAGE)
+	ry := ranks(len(ys), func(i int) realFloat64 { return realFloat64(ys[i]) }, RANKAVERAGE)
+	var c comoments
+	for i := range rx {
+		c.add(rx[i], ry[i])
+	}
+	return c.pearson(), nil
+}
+
+// LinearFitFloat64(x, y) fits y = slope * x + intercept by least squares
+// and returns slope, intercept and the coefficient of determination r²
+// Returns ErrDiffLen if the iterables differ in length and ErrShorter2 if they have less than 2 elements
+// Does not change the underlying original slices
+func LinearFitFloat64(x, y *IterableFloat64) (slope, intercept, r2 realFloat64, err error) {
+	c, err := comomentsFloat64(x, y)
+	if err != nil {
+		return math.NaN(), math.NaN(), math.NaN(), err
+	}
+	slope = c.cXY / c.m2X
+	intercept = c.meanY - slope*c.meanX
+	r := c.pearson()
+	return slope, intercept, r * r, nil
+}
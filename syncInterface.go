@@ -0,0 +1,209 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Concurrent consumption of IterableIf
+// Every iterable keeps its index and exhaustion state in captured variables, so goroutines sharing
+// an iterable race on them. Synchronized guards this state with a mutex, AtomicNext hands out
+// the elements without touching it.
+
+// Synchronized() returns an iterable over the same underlying slice and index whose functions are guarded
+// by one mutex, so goroutines may share it (i.e. for Next, Reset, Map or Any)
+// The stepwise functions (MapNext, FilterNext ...) and Cycle return functions guarded by the same mutex;
+// use them to get an element together with the exhaustion indicator in one step.
+// Only calls through the returned iterable are guarded, the functions passed to it run under the lock
+// and must not call it again
+// List is not guarded: it returns the underlying slice itself, so neither are the functions reading it
+// (the methods like ParallelMap or Accumulate); call them only while no goroutine changes the elements (MapInto)
+// Destroy empties the wrapper and the original, do not call it while other goroutines use the wrapper
+// Does not change the underlying original slice
+func (iter *IterableIf) Synchronized() *IterableIf {
+	var (
+		mu     sync.Mutex
+		orig   = *iter
+		synced = orig
+	)
+	lockNext := func(next func() (interface{}, bool)) func() (interface{}, bool) {
+		return func() (interface{}, bool) {
+			mu.Lock()
+			defer mu.Unlock()
+			return next()
+		}
+	}
+
+	synced.Reset = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		orig.Reset()
+	}
+	synced.ToEnd = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		orig.ToEnd()
+	}
+	lockElem := func(elem func() interface{}) func() interface{} {
+		return func() interface{} {
+			mu.Lock()
+			defer mu.Unlock()
+			return elem()
+		}
+	}
+	synced.Next = lockElem(orig.Next)
+	synced.This = lockElem(orig.This)
+	synced.Back = lockElem(orig.Back)
+	synced.First = lockElem(orig.First)
+	synced.Last = lockElem(orig.Last)
+	synced.Cycle = func() func() interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return lockElem(orig.Cycle())
+	}
+	synced.MapNext = func(fn func(interface{}) interface{}) func() (interface{}, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		return lockNext(orig.MapNext(fn))
+	}
+	synced.FilterNext = func(cond func(interface{}) bool) func() (interface{}, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		return lockNext(orig.FilterNext(cond))
+	}
+	synced.DoubleOpNext = func(fn func(interface{}, interface{}) interface{}) func() (interface{}, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		return lockNext(orig.DoubleOpNext(fn))
+	}
+	synced.DoubleCompNext = func(fn func(interface{}, interface{}) bool) func() (interface{}, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		return lockNext(orig.DoubleCompNext(fn))
+	}
+	synced.PairOpNext = func(fn func(interface{}, interface{}) interface{}, stp ...int) func() (interface{}, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		return lockNext(orig.PairOpNext(fn, stp...))
+	}
+
+	synced.Index = func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.Index()
+	}
+	synced.SetIndex = func(idx int) (int, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.SetIndex(idx)
+	}
+	synced.Any = func(needle interface{}) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.Any(needle)
+	}
+	synced.All = func(needle interface{}) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.All(needle)
+	}
+	synced.Where = func(cond func(interface{}) bool) *IterableInt {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.Where(cond)
+	}
+
+	synced.Reduce = func(fn func(interface{}, interface{}) interface{}) interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.Reduce(fn)
+	}
+	synced.Tee = func(n int) []*IterableIf {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.Tee(n)
+	}
+
+	synced.DoubleOp = func(fn func(interface{}, interface{}) interface{}) *IterableIf {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.DoubleOp(fn)
+	}
+	synced.DoubleComp = func(fn func(interface{}, interface{}) bool) *IterableIf {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.DoubleComp(fn)
+	}
+	synced.PairOp = func(fn func(interface{}, interface{}) interface{}, stp ...int) *IterableIf {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.PairOp(fn, stp...)
+	}
+	synced.Filter = func(cond func(interface{}) bool) *IterableIf {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.Filter(cond)
+	}
+	synced.Map = func(fn func(interface{}) interface{}) *IterableIf {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.Map(fn)
+	}
+	synced.MapInto = func(fn func(interface{}) interface{}) *IterableIf {
+		mu.Lock()
+		defer mu.Unlock()
+		orig.MapInto(fn)
+		return &synced
+	}
+	synced.Destroy = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		orig.Destroy()
+		// like the original the wrapper (with its Len and List) becomes an empty iterable
+		synced = *ToIterIf(make([]interface{}, 0)).Synchronized()
+	}
+	synced.ToList = func() []interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return orig.ToList()
+	}
+	return &synced
+}
+
+// AtomicNext() returns a function handing out the elements (nil when exhausted) and a bool indicator for the exhaustion
+// to any number of goroutines: every element is returned exactly once (in the order of an atomic counter),
+// so the goroutines can steal work from a shared iterable without locks
+// Neither uses nor changes the iterable's index
+// Does not change the underlying original slice
+func (iter *IterableIf) AtomicNext() func() (interface{}, bool) {
+	s := iter.List()
+	var next int64 = -1
+	return func() (interface{}, bool) {
+		i := atomic.AddInt64(&next, 1)
+		if i >= int64(len(s)) {
+			return nil, true
+		}
+		return s[i], false
+	}
+}
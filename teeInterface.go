@@ -0,0 +1,103 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "sync"
+
+// Tee copies of IterableIf and stepwise interface{} sources (like Python's itertools.tee)
+// Unlike Tee, which splits the elements into parts, every copy returns the full sequence.
+
+// teeIf buffers the elements of next between the slowest and the fastest copy
+type teeIf struct {
+	mu    sync.Mutex
+	next  func() (interface{}, bool)
+	buf   []interface{}
+	start int   // position of buf[0] in the sequence
+	pos   []int // position of the next element of every copy
+	done  bool
+}
+
+// get returns the next element of copy i and a bool indicator for the exhaustion
+func (t *teeIf) get(i int) (interface{}, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p := t.pos[i]
+	if p-t.start >= len(t.buf) {
+		if t.done {
+			return nil, true
+		}
+		v, exhausted := t.next()
+		if exhausted {
+			t.done = true
+			return nil, true
+		}
+		t.buf = append(t.buf, v)
+	}
+	v := t.buf[p-t.start]
+	t.pos[i]++
+	// drop the elements every copy got
+	min := t.pos[0]
+	for _, q := range t.pos[1:] {
+		if q < min {
+			min = q
+		}
+	}
+	if min > t.start {
+		t.buf = t.buf[min-t.start:]
+		t.start = min
+	}
+	return v, false
+}
+
+// TeeNextIf(next, n) returns n independent stepwise functions each returning all elements of the stepwise
+// source next (i.e. a generator or channel) and a bool indicator for the exhaustion
+// next is called once per element, the elements are buffered only between the slowest and the fastest copy
+// The copies may be used by different goroutines, next is called under a lock
+// Uses memory (buffer of the span between the slowest and the fastest copy)
+func TeeNextIf(next func() (interface{}, bool), n int) []func() (interface{}, bool) {
+	if n < 1 {
+		panic(ERR_COPIES)
+	}
+	t := &teeIf{next: next, pos: make([]int, n)}
+	copies := make([]func() (interface{}, bool), n)
+	for i := range copies {
+		i := i
+		copies[i] = func() (interface{}, bool) { return t.get(i) }
+	}
+	return copies
+}
+
+// TeeCopies(n) returns n independent stepwise functions each returning all elements of the iterable
+// and a bool indicator for the exhaustion (s. TeeNextIf); the copies are Cursors over the underlying
+// slice, so no buffer is needed
+// Neither uses nor changes the iterable's index
+// Does not change the underlying original slice
+func (iter *IterableIf) TeeCopies(n int) []func() (interface{}, bool) {
+	if n < 1 {
+		panic(ERR_COPIES)
+	}
+	copies := make([]func() (interface{}, bool), n)
+	for i := range copies {
+		copies[i] = iter.Cursor().Next
+	}
+	return copies
+}
@@ -0,0 +1,362 @@
+//
+// The MIT License (MIT)
+// Copyright (c) 2018 Andreas Briese, eduToolbox@Bri-C GmbH, Sarstedt
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package itertools
+
+import "math"
+
+// Element-wise (vector) arithmetic of IterableFloat64
+// Every operation exists between two iterables of the same length (i.e. Add) and between
+// the iterable and a scalar (i.e. AddScalar); the *Into variants write the result into
+// the underlying slice and return the very same iterable like MapInto.
+// Note! Integer types use Go's integer arithmetic (Div truncates and panics on division by zero).
+// Minimum and Maximum propagate NaN of either side like numpy.minimum and numpy.maximum.
+
+// vecOpFloat64 writes a[i] op b[i] (or a[i] op c if b is nil) to dst[i]
+// The loops are specialized per op to avoid a function call per element
+func vecOpFloat64(op int, dst, a, b []float64, c float64) {
+	if b != nil && len(b) != len(a) {
+		panic(ERR_DIFFLEN)
+	}
+	switch op {
+	case vecADD:
+		if b == nil {
+			for i, v := range a {
+				dst[i] = v + c
+			}
+			return
+		}
+		for i, v := range a {
+			dst[i] = v + b[i]
+		}
+	case vecSUB:
+		if b == nil {
+			for i, v := range a {
+				dst[i] = v - c
+			}
+			return
+		}
+		for i, v := range a {
+			dst[i] = v - b[i]
+		}
+	case vecMUL:
+		if b == nil {
+			for i, v := range a {
+				dst[i] = v * c
+			}
+			return
+		}
+		for i, v := range a {
+			dst[i] = v * b[i]
+		}
+	case vecDIV:
+		if b == nil {
+			for i, v := range a {
+				dst[i] = v / c
+			}
+			return
+		}
+		for i, v := range a {
+			dst[i] = v / b[i]
+		}
+	case vecPOW:
+		if b == nil {
+			for i, v := range a {
+				dst[i] = float64(math.Pow(realFloat64(v), realFloat64(c)))
+			}
+			return
+		}
+		for i, v := range a {
+			dst[i] = float64(math.Pow(realFloat64(v), realFloat64(b[i])))
+		}
+	case vecMINIMUM:
+		for i, v := range a {
+			if b != nil {
+				c = b[i]
+			}
+			if c < v || math.IsNaN(realFloat64(c)) {
+				v = c
+			}
+			dst[i] = v
+		}
+	case vecMAXIMUM:
+		for i, v := range a {
+			if b != nil {
+				c = b[i]
+			}
+			if c > v || math.IsNaN(realFloat64(c)) {
+				v = c
+			}
+			dst[i] = v
+		}
+	}
+}
+
+// vecFloat64 returns a new iterable with the results of vecOpFloat64
+func vecFloat64(op int, a, b []float64, c float64) *IterableFloat64 {
+	newIter := make([]float64, len(a))
+	vecOpFloat64(op, newIter, a, b, c)
+	return ToIterFloat64(newIter)
+}
+
+// Add(other) returns a new iterable with the element-wise sums iter[i] + other[i]
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Add(other *IterableFloat64) *IterableFloat64 {
+	return vecFloat64(vecADD, iter.List(), other.List(), 0)
+}
+
+// AddScalar(c) returns a new iterable with the element-wise sums iter[i] + c
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) AddScalar(c float64) *IterableFloat64 {
+	return vecFloat64(vecADD, iter.List(), nil, c)
+}
+
+// AddInto(other) adds other element-wise changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) AddInto(other *IterableFloat64) *IterableFloat64 {
+	vecOpFloat64(vecADD, iter.List(), iter.List(), other.List(), 0)
+	return iter
+}
+
+// AddScalarInto(c) adds c to every element changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) AddScalarInto(c float64) *IterableFloat64 {
+	vecOpFloat64(vecADD, iter.List(), iter.List(), nil, c)
+	return iter
+}
+
+// Sub(other) returns a new iterable with the element-wise differences iter[i] - other[i]
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Sub(other *IterableFloat64) *IterableFloat64 {
+	return vecFloat64(vecSUB, iter.List(), other.List(), 0)
+}
+
+// SubScalar(c) returns a new iterable with the element-wise differences iter[i] - c
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) SubScalar(c float64) *IterableFloat64 {
+	return vecFloat64(vecSUB, iter.List(), nil, c)
+}
+
+// SubInto(other) subtracts other element-wise changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) SubInto(other *IterableFloat64) *IterableFloat64 {
+	vecOpFloat64(vecSUB, iter.List(), iter.List(), other.List(), 0)
+	return iter
+}
+
+// SubScalarInto(c) subtracts c from every element changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) SubScalarInto(c float64) *IterableFloat64 {
+	vecOpFloat64(vecSUB, iter.List(), iter.List(), nil, c)
+	return iter
+}
+
+// Mul(other) returns a new iterable with the element-wise products iter[i] * other[i]
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Mul(other *IterableFloat64) *IterableFloat64 {
+	return vecFloat64(vecMUL, iter.List(), other.List(), 0)
+}
+
+// MulScalar(c) returns a new iterable with the element-wise products iter[i] * c
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) MulScalar(c float64) *IterableFloat64 {
+	return vecFloat64(vecMUL, iter.List(), nil, c)
+}
+
+// MulInto(other) multiplies by other element-wise changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) MulInto(other *IterableFloat64) *IterableFloat64 {
+	vecOpFloat64(vecMUL, iter.List(), iter.List(), other.List(), 0)
+	return iter
+}
+
+// MulScalarInto(c) multiplies every element by c changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) MulScalarInto(c float64) *IterableFloat64 {
+	vecOpFloat64(vecMUL, iter.List(), iter.List(), nil, c)
+	return iter
+}
+
+// Div(other) returns a new iterable with the element-wise quotients iter[i] / other[i]
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Div(other *IterableFloat64) *IterableFloat64 {
+	return vecFloat64(vecDIV, iter.List(), other.List(), 0)
+}
+
+// DivScalar(c) returns a new iterable with the element-wise quotients iter[i] / c
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) DivScalar(c float64) *IterableFloat64 {
+	return vecFloat64(vecDIV, iter.List(), nil, c)
+}
+
+// DivInto(other) divides by other element-wise changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) DivInto(other *IterableFloat64) *IterableFloat64 {
+	vecOpFloat64(vecDIV, iter.List(), iter.List(), other.List(), 0)
+	return iter
+}
+
+// DivScalarInto(c) divides every element by c changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) DivScalarInto(c float64) *IterableFloat64 {
+	vecOpFloat64(vecDIV, iter.List(), iter.List(), nil, c)
+	return iter
+}
+
+// Pow(other) returns a new iterable with the element-wise powers iter[i] ** other[i] (math.Pow)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Pow(other *IterableFloat64) *IterableFloat64 {
+	return vecFloat64(vecPOW, iter.List(), other.List(), 0)
+}
+
+// PowScalar(c) returns a new iterable with the element-wise powers iter[i] ** c (math.Pow)
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) PowScalar(c float64) *IterableFloat64 {
+	return vecFloat64(vecPOW, iter.List(), nil, c)
+}
+
+// PowInto(other) raises to the power of other element-wise changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) PowInto(other *IterableFloat64) *IterableFloat64 {
+	vecOpFloat64(vecPOW, iter.List(), iter.List(), other.List(), 0)
+	return iter
+}
+
+// PowScalarInto(c) raises every element to the power of c changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) PowScalarInto(c float64) *IterableFloat64 {
+	vecOpFloat64(vecPOW, iter.List(), iter.List(), nil, c)
+	return iter
+}
+
+// Minimum(other) returns a new iterable with the element-wise minimum of iter[i] and other[i]
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Minimum(other *IterableFloat64) *IterableFloat64 {
+	return vecFloat64(vecMINIMUM, iter.List(), other.List(), 0)
+}
+
+// MinimumScalar(c) returns a new iterable with the element-wise minimum of iter[i] and c
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) MinimumScalar(c float64) *IterableFloat64 {
+	return vecFloat64(vecMINIMUM, iter.List(), nil, c)
+}
+
+// MinimumInto(other) takes the element-wise minimum with other changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) MinimumInto(other *IterableFloat64) *IterableFloat64 {
+	vecOpFloat64(vecMINIMUM, iter.List(), iter.List(), other.List(), 0)
+	return iter
+}
+
+// MinimumScalarInto(c) takes the element-wise minimum with c changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) MinimumScalarInto(c float64) *IterableFloat64 {
+	vecOpFloat64(vecMINIMUM, iter.List(), iter.List(), nil, c)
+	return iter
+}
+
+// Maximum(other) returns a new iterable with the element-wise maximum of iter[i] and other[i]
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Maximum(other *IterableFloat64) *IterableFloat64 {
+	return vecFloat64(vecMAXIMUM, iter.List(), other.List(), 0)
+}
+
+// MaximumScalar(c) returns a new iterable with the element-wise maximum of iter[i] and c
+// Uses memory (new slice with the originals dimensions) and the new iterable refers to this new slice
+// Does not change the underlying original slice
+func (iter *IterableFloat64) MaximumScalar(c float64) *IterableFloat64 {
+	return vecFloat64(vecMAXIMUM, iter.List(), nil, c)
+}
+
+// MaximumInto(other) takes the element-wise maximum with other changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) MaximumInto(other *IterableFloat64) *IterableFloat64 {
+	vecOpFloat64(vecMAXIMUM, iter.List(), iter.List(), other.List(), 0)
+	return iter
+}
+
+// MaximumScalarInto(c) takes the element-wise maximum with c changing the underlying slice to the result and returns itself
+// No additional memory needed
+func (iter *IterableFloat64) MaximumScalarInto(c float64) *IterableFloat64 {
+	vecOpFloat64(vecMAXIMUM, iter.List(), iter.List(), nil, c)
+	return iter
+}
+
+// Dot(other) returns the dot product sum(iter[i] * other[i]) accumulated in sumFloat64
+// Does not change the underlying original slices
+func (iter *IterableFloat64) Dot(other *IterableFloat64) sumFloat64 {
+	a, b := iter.List(), other.List()
+	if len(a) != len(b) {
+		panic(ERR_DIFFLEN)
+	}
+	var dot sumFloat64
+	for i, v := range a {
+		dot += sumFloat64(v) * sumFloat64(b[i])
+	}
+	return dot
+}
+
+// Norm([ord=NORML2]) returns the vector norm NORML1 (sum of |x|), NORML2 (euclidean) or NORMINF (max |x|)
+// Does not change the underlying original slice
+func (iter *IterableFloat64) Norm(ord ...int) realFloat64 {
+	o := NORML2
+	if len(ord) == 1 {
+		o = ord[0]
+	}
+	norm := realFloat64(0)
+	switch o {
+	case NORML1:
+		for _, v := range iter.List() {
+			norm += math.Abs(realFloat64(v))
+		}
+	case NORML2:
+		for _, v := range iter.List() {
+			norm += realFloat64(v) * realFloat64(v)
+		}
+		norm = math.Sqrt(norm)
+	case NORMINF:
+		for _, v := range iter.List() {
+			norm = math.Max(norm, math.Abs(realFloat64(v)))
+		}
+	default:
+		panic(ERR_METHOD)
+	}
+	return norm
+}
+
+// CosineSimilarity(other) returns the cosine of the angle between the two vectors dot / (|iter| * |other|)
+// Does not change the underlying original slices
+func (iter *IterableFloat64) CosineSimilarity(other *IterableFloat64) realFloat64 {
+	return realFloat64(iter.Dot(other)) / (iter.Norm() * other.Norm())
+}